@@ -2,17 +2,35 @@ package monkeymock
 
 import (
 	"fmt"
-	"testing"
 )
 
-func tFail(t *testing.T, failureMessage string) {
+// TestingT is the subset of testing.TB used by MonkeyMock to report failures and register
+// cleanup. It is satisfied by *testing.T, *testing.B and *testing.F, as well as any fake
+// that implements these methods.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	Fatalf(format string, args ...interface{})
+	Logf(format string, args ...interface{})
+	Cleanup(func())
+}
+
+func tFail(t TestingT, failureMessage string) {
 	t.Helper()
 	t.Errorf("MonkeyMock.AssertExpectations failed\n%s\n", failureMessage)
 }
-func tFatal(t *testing.T, failureMessage string) {
+func tFailReceived(t TestingT, failureMessage string) {
+	t.Helper()
+	t.Errorf("MonkeyMock.AssertReceived failed\n%s\n", failureMessage)
+}
+func tFatal(t TestingT, failureMessage string) {
 	t.Helper()
 	t.Fatalf("MonkeyMock.AssertExpectations failed (FATAL)\n%s\n", failureMessage)
 }
+func tLog(t TestingT, logMessage string) {
+	t.Helper()
+	t.Logf("MonkeyMock.AssertExpectations\n%s\n", logMessage)
+}
 func tPanicMockRuntime(failureMessage string) {
 	panicMsg := fmt.Sprintf("MonkeyMock PANIC at runtime: \n"+
 		"%s\n", failureMessage)
@@ -20,6 +20,12 @@ will clear all expectations that been configured thus far.
 If this workflow does not fit your use case, see [AssertExpectations] for
 details on how to preserve state across multiple calls for general assertion.
 
+Alternatively, [ExpectT] binds a Mock to the lifetime of a single test (or
+benchmark, or fuzz target), verifying it and removing its partial intercepts
+automatically once the test ends. Tests running in parallel should each use
+their own [Controller] (see [NewController]), so that they neither see nor
+verify each other's Mocks.
+
 
 
 Message expectations are verified
@@ -1,9 +1,14 @@
 module github.com/eshork/monkeymock
 
-go 1.12
+go 1.18
 
 require (
 	bou.ke/monkey v1.0.1
 	github.com/brianvoe/gofakeit v3.17.0+incompatible
 	github.com/stretchr/testify v1.3.0
 )
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+)
@@ -12,6 +12,8 @@ type Mock interface {
 	mockMethodCallInterface
 	mockDoubleInterface
 	mockPartialInterface
+	mockCallHistoryInterface
+	Expectation // the most recently declared ToReceive
 	// mockCallableInterface
 	// mockCallCounterInterface
 }
@@ -22,6 +24,8 @@ type mockStruct struct {
 	mockMethodContainerStruct
 
 	mockedObjectRef interface{}
+	controller      *Controller // the Controller owning this Mock
+	isSpy           bool        // true if created via Spy (its expectations only record calls)
 }
 
 // Expect is the first step to building an expectation around a thing, either a type or an object
@@ -30,60 +34,93 @@ type mockStruct struct {
 // Each call to Expect creates a new Mock and thus begins defining a new expectation that can be evaluated
 // for completion accuracy en masse (the typical method) via  ([AssertExpectations]), or on an individual
 // basis by directly calling ([Mock.Assert]) for each mock you'd like to evaluate.
+// Mocks created via Expect belong to the default Controller; see NewController for isolated Mocks.
 func Expect(refObject interface{}) Mock {
-	validateIsMockableObjectRef(refObject)
-	mock := new(mockStruct)          // every Expect is a new assert condition...
-	mock.mockedObjectRef = refObject // store a reference to the original object/interface
-	appendToMockList(mock)           // throw it onto the FIFO stack...
-	return mock                      // make condition stacking easy...
+	return gDefaultController.Expect(refObject)
 }
 
-///////////////////////////////////////////////////////////////////////////////
-///////////////////////////////////////////////////////////////////////////////
+// ExpectT is Expect, bound to the lifetime of the given test (or benchmark, or fuzz target).
+// When the test ends, the Mock is verified via [Mock.AssertExpectations] and then dropped,
+// along with any partial intercepts that no other Mock still relies upon.
+func ExpectT(t TestingT, refObject interface{}) Mock {
+	t.Helper()
+	mock := Expect(refObject).(*mockStruct)
+	t.Cleanup(func() {
+		t.Helper()
+		mock.AssertExpectations(t)
+		releaseMock(mock)
+	})
+	return mock
+}
 
-//// Global Mock list management - as every Expect is supposed to generate an expectation by default!
+// removes the given Mock from the MockList of its Controller, along with the partial intercepts used only by it
+func releaseMock(mock *mockStruct) {
+	mock.controller.removeFromMockList(mock)
+	clearUnusedPartialObjectMethodIntercepts()
+}
 
-//
+///////////////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
 
-var gTheMockList []Mock
+//// Mock list management - as every Expect is supposed to generate an expectation by default!
 
 // adds the given Mock to the end of the current MockList (starts a new list if necessary)
-func appendToMockList(newmock Mock) {
-	gTheMockList = append(gTheMockList, newmock)
+func (c *Controller) appendToMockList(newmock Mock) {
+	gRegistryLock.Lock()
+	defer gRegistryLock.Unlock()
+	c.mockList = append(c.mockList, newmock)
 }
 
 // removes the given Mock from the MockList (may result in 0 size list; you don't care)
-func removeFromMockList(mock Mock) {
-	if gTheMockList == nil {
+func (c *Controller) removeFromMockList(mock Mock) {
+	gRegistryLock.Lock()
+	defer gRegistryLock.Unlock()
+	if c.mockList == nil {
 		return
 	}
-	for i, v := range gTheMockList {
+	for i, v := range c.mockList {
 		if mock == v {
 			if i == 0 {
-				gTheMockList = gTheMockList[1:] // trim the first item off the list
+				c.mockList = c.mockList[1:] // trim the first item off the list
 				return
 			}
-			if lastI := len(gTheMockList) - 1; i == lastI {
-				gTheMockList = gTheMockList[:lastI] // trim the last item off the list
+			if lastI := len(c.mockList) - 1; i == lastI {
+				c.mockList = c.mockList[:lastI] // trim the last item off the list
 				return
 			}
-			newList := make([]Mock, len(gTheMockList)-1)
-			copy(newList, gTheMockList[:i])
-			copy(newList[i:], gTheMockList[i+1:])
-			gTheMockList = newList
+			newList := make([]Mock, len(c.mockList)-1)
+			copy(newList, c.mockList[:i])
+			copy(newList[i:], c.mockList[i+1:])
+			c.mockList = newList
 		}
 	}
 }
 
 // drops the current MockList entirely, so a new one may be started
-func clearMockList() {
-	gTheMockList = nil // that was easy...
+func (c *Controller) clearMockList() {
+	gRegistryLock.Lock()
+	defer gRegistryLock.Unlock()
+	c.mockList = nil // that was easy...
+}
+
+func (c *Controller) sizeOfMockList() int {
+	gRegistryLock.RLock()
+	defer gRegistryLock.RUnlock()
+	return len(c.mockList)
 }
 
-func sizeOfMockList() int {
-	return len(gTheMockList)
+// returns a snapshot of the current MockList
+func (c *Controller) mocks() []Mock {
+	gRegistryLock.RLock()
+	defer gRegistryLock.RUnlock()
+	return append([]Mock(nil), c.mockList...)
 }
 
+// shorthands for the MockList of the default Controller
+func removeFromMockList(mock Mock) { gDefaultController.removeFromMockList(mock) }
+func clearMockList()               { gDefaultController.clearMockList() }
+func sizeOfMockList() int          { return gDefaultController.sizeOfMockList() }
+
 ///////////////////////////////////////////////////////////////////////////////
 ///////////////////////////////////////////////////////////////////////////////
 
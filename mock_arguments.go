@@ -1,8 +1,183 @@
 package monkeymock
 
-// import (
-// 	"fmt"
-// 	"reflect"
-// 	"strings"
-// 	"testing"
-// )
+import (
+	"reflect"
+)
+
+// returns true when the mockMethod declared a specific set of arguments (via WithArgs)
+// - WithAnyArgs, or no argument declaration at all, act as a default handler
+func (m *mockMethodStruct) hasArgsExpectation() bool {
+	return m.expectedArgsValues != nil && !m.expectedArgsAny
+}
+
+// returns true if the given call arguments satisfy the mockMethod argument expectations
+func (m *mockMethodStruct) matchesArgs(args methodArgumentsList) bool {
+	if !m.hasArgsExpectation() {
+		return true // default handlers match everything
+	}
+	if len(args) != len(m.expectedArgsValues) {
+		return false
+	}
+	for i, expected := range m.expectedArgsValues {
+		if !argumentMatches(expected, args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// returns a ranking of how specific the mockMethod argument expectations are;
+// higher values are more specific, with zero reserved for default handlers
+// - any WithArgs declaration outranks a default handler
+// - each literal argument value outranks an argument matcher
+func (m *mockMethodStruct) argsSpecificity() int {
+	if !m.hasArgsExpectation() {
+		return 0
+	}
+	specificity := 1
+	for _, expected := range m.expectedArgsValues {
+		if variadic, ok := expected.(*variadicArgsMatcher); ok && variadic.isLiteral() {
+			specificity++ // literal variadic elements rank as a literal argument
+			continue
+		}
+		if _, isMatcher := asArgumentMatcher(expected); !isMatcher {
+			specificity++
+		}
+	}
+	return specificity
+}
+
+// type checks the given WithArgs values against the method signature (panics if they mismatch),
+// returning them in the form they are matched against calls
+// - variadic elements may be given flattened or as a single slice value; either way they're stored as one matcher
+// - values are stored as the exact parameter types (ie, untyped 7 for an int64 parameter becomes int64(7))
+func (m *mockMethodStruct) expectedArgsFor(args methodArgumentsList) methodArgumentsList {
+	m.ensureMethodArgs(args)
+	if m.isVariadic() {
+		return m.variadicExpectedArgs(args)
+	}
+	return convertValuesToTypes(args, m.getObjectMethodArgTypes())
+}
+
+// returns true if the given expected argument value (or matcher) matches the actual received argument
+func argumentMatches(expected interface{}, actual interface{}) bool {
+	if matcher, ok := asArgumentMatcher(expected); ok {
+		return matcher.Matches(actual)
+	}
+	return reflect.DeepEqual(expected, actual)
+}
+
+///////////////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// matches the variadic slice received by a variadic method, element by element
+// - WithArgs declarations for variadic methods are normalized into this form, so that variadic args
+// match the same calls whether they were declared flattened or as a single slice value
+type variadicArgsMatcher struct {
+	elements []interface{} // expected values (or matchers) of each variadic element
+}
+
+func (vm *variadicArgsMatcher) Matches(arg interface{}) bool {
+	slice := reflect.ValueOf(arg)
+	if slice.Kind() != reflect.Slice || slice.Len() != len(vm.elements) {
+		return false
+	}
+	for i, expected := range vm.elements {
+		if !argumentMatches(expected, slice.Index(i).Interface()) {
+			return false
+		}
+	}
+	return true
+}
+
+func (vm *variadicArgsMatcher) String() string {
+	return "variadic(" + stringifyValuesList(vm.elements) + ")"
+}
+
+// returns true if every variadic element is a literal value, rather than a matcher
+func (vm *variadicArgsMatcher) isLiteral() bool {
+	for _, v := range vm.elements {
+		if _, isMatcher := asArgumentMatcher(v); isMatcher {
+			return false
+		}
+	}
+	return true
+}
+
+// returns true if the mocked method takes a variadic final parameter
+func (m *mockMethodStruct) isVariadic() bool {
+	methodPtr := getObjectMethodByName(m.parentMockStruct.mockedObjectRef, m.methodName)
+	return methodPtr != nil && methodPtr.Type.IsVariadic()
+}
+
+// returns true if the given args list passes the variadic elements as a single slice value
+// (ie, the equivalent of `obj.Method(fixed, slice...)`)
+func isVariadicSliceForm(args methodArgumentsList, paramTypes []reflect.Type) bool {
+	numFixed := len(paramTypes) - 1
+	return len(args) == len(paramTypes) && reflect.TypeOf(args[numFixed]) == paramTypes[numFixed]
+}
+
+// returns the variadic elements of the given args list, whether given flattened or as a single slice value
+// - the args list must hold at least the fixed (non-variadic) args
+func (m *mockMethodStruct) variadicElements(args methodArgumentsList) []interface{} {
+	paramTypes := m.getObjectMethodArgTypes()
+	numFixed := len(paramTypes) - 1
+	if !isVariadicSliceForm(args, paramTypes) {
+		return copyInterfaceList(args[numFixed:])
+	}
+	slice := reflect.ValueOf(args[numFixed])
+	elements := make([]interface{}, slice.Len())
+	for i := range elements {
+		elements[i] = slice.Index(i).Interface()
+	}
+	return elements
+}
+
+// returns the WithArgs values of a variadic method, with the variadic elements gathered into a variadicArgsMatcher
+// - values are converted to their exact parameter (or element) types, see convertValuesToTypes
+func (m *mockMethodStruct) variadicExpectedArgs(args methodArgumentsList) methodArgumentsList {
+	paramTypes := m.getObjectMethodArgTypes()
+	numFixed := len(paramTypes) - 1
+	expectedArgs := convertValuesToTypes(args[:numFixed], paramTypes[:numFixed])
+	elements := m.variadicElements(args)
+	elementTypes := make([]reflect.Type, len(elements))
+	for i := range elementTypes {
+		elementTypes[i] = paramTypes[numFixed].Elem()
+	}
+	return append(expectedArgs, &variadicArgsMatcher{elements: convertValuesToTypes(elements, elementTypes)})
+}
+
+// returns the call args in the form they are matched and recorded in
+// - values are converted to their exact parameter types, see convertValuesToTypes
+// - the variadic args of a variadic method are packed into a single slice value, see variadicCallArgs
+func (m *mockMethodStruct) normalizeCallArgs(args methodArgumentsList) methodArgumentsList {
+	if m.isVariadic() {
+		args = m.variadicCallArgs(args)
+	}
+	paramTypes := m.getObjectMethodArgTypes()
+	if len(args) != len(paramTypes) {
+		return args // will fail signature validation
+	}
+	return convertValuesToTypes(args, paramTypes)
+}
+
+// returns the call args of a variadic method in slice form, with the variadic elements packed into a
+// single slice value (as received by partial intercepts)
+// - args already in slice form, or that cannot be packed, are returned as they are
+func (m *mockMethodStruct) variadicCallArgs(args methodArgumentsList) methodArgumentsList {
+	paramTypes := m.getObjectMethodArgTypes()
+	numFixed := len(paramTypes) - 1
+	if len(args) < numFixed || isVariadicSliceForm(args, paramTypes) {
+		return args
+	}
+	sliceType := paramTypes[numFixed]
+	elements := args[numFixed:]
+	slice := reflect.MakeSlice(sliceType, len(elements), len(elements))
+	for i, v := range elements {
+		if !isValueValidForType(v, sliceType.Elem()) {
+			return args
+		}
+		slice.Index(i).Set(valueOfType(v, sliceType.Elem()))
+	}
+	return append(copyInterfaceList(args[:numFixed]), slice.Interface())
+}
@@ -0,0 +1,159 @@
+package monkeymock
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Captor is an argument matcher that accepts any value of the parameter type it is given for,
+// and captures the argument of every call dispatched to its expectation, for assertions made
+// after the fact (ie, generated IDs, or request structs built internally):
+//
+//	request := monkeymock.NewCaptor()
+//	mock := monkeymock.Expect(client).ToReceive("Send").WithArgs(request).WithReturns(nil).AsPartial()
+//	service.Run(client)
+//	sent := monkeymock.CapturedValue[*Request](request)
+//
+// The parameter type is learned when the Captor is given to WithArgs. A Captor may be shared
+// between several expectations, as long as the parameter types agree. Given to the WithArgs
+// of AssertReceived instead, a Captor captures the argument of every call verified. Captors
+// cannot be nested within And, Or or Not, as those cannot tell which calls to capture.
+type Captor struct {
+	lock      sync.Mutex
+	paramType reflect.Type  // the parameter type, once bound by WithArgs
+	values    []interface{} // every captured value, in call order
+}
+
+// NewCaptor creates a Captor, for use in place of an argument within WithArgs
+func NewCaptor() *Captor {
+	return new(Captor)
+}
+
+// Matches accepts any value valid for the parameter type of the Captor (nothing is captured until
+// the call has been dispatched to the expectation)
+func (c *Captor) Matches(arg interface{}) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.paramType == nil || isValueValidForType(arg, c.paramType)
+}
+
+func (c *Captor) String() string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.paramType == nil {
+		return "Captor()"
+	}
+	return fmt.Sprintf("Captor(<%s>)", c.paramType.String())
+}
+
+// Value returns the most recently captured value. Panics if nothing has been captured.
+func (c *Captor) Value() interface{} {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if len(c.values) == 0 {
+		panicCaptorEmpty(c.paramType)
+	}
+	return c.values[len(c.values)-1]
+}
+
+// Values returns every captured value, in call order
+func (c *Captor) Values() []interface{} {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return copyInterfaceList(c.values)
+}
+
+// CapturedValue returns the most recently captured value of the given Captor as type T.
+// Panics if nothing has been captured, or if the value is not of type T.
+func CapturedValue[T any](c *Captor) T {
+	return capturedAs[T](c, c.Value())
+}
+
+// CapturedValues returns every captured value of the given Captor as type T, in call order.
+// Panics if any value is not of type T.
+func CapturedValues[T any](c *Captor) []T {
+	values := c.Values()
+	retVal := make([]T, len(values))
+	for i, v := range values {
+		retVal[i] = capturedAs[T](c, v)
+	}
+	return retVal
+}
+
+// returns the given captured value as type T (nil becomes the zero value of a nillable T)
+func capturedAs[T any](c *Captor, value interface{}) T {
+	wantType := reflect.TypeOf((*T)(nil)).Elem()
+	if value == nil && isNillableKind(wantType.Kind()) {
+		var zero T
+		return zero
+	}
+	typed, ok := value.(T)
+	if !ok {
+		panicCaptorTypeMismatch(c.String(), wantType, value)
+	}
+	return typed
+}
+
+// binds the Captor to the given parameter type, or panics if already bound to another type
+// - called by the WithArgs type check (see ensureMethodArgs)
+func (c *Captor) bindParamType(m *mockMethodStruct, paramType reflect.Type) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.paramType != nil && c.paramType != paramType {
+		panicCaptorParamTypeConflict(m, c.paramType, paramType)
+	}
+	c.paramType = paramType
+}
+
+func (c *Captor) capture(value interface{}) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.values = append(c.values, value)
+}
+
+// binds any Captor given as an expected argument to the given parameter type
+// - panics for Captors nested within a combined matcher (And, Or, Not), which would never capture
+func (m *mockMethodStruct) bindCaptor(expected interface{}, paramType reflect.Type) {
+	if captor, ok := expected.(*Captor); ok {
+		captor.bindParamType(m, paramType)
+		return
+	}
+	if matcher, ok := expected.(ArgumentMatcher); ok && containsNestedCaptor(matcher) {
+		panicCaptorNested(m, matcher.String())
+	}
+}
+
+// returns true if the given matcher combines (at any depth) a Captor
+func containsNestedCaptor(matcher ArgumentMatcher) bool {
+	combined, ok := matcher.(*funcMatcher)
+	if !ok {
+		return false
+	}
+	for _, v := range combined.nested {
+		if _, isCaptor := v.(*Captor); isCaptor || containsNestedCaptor(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// captures the given (normalized) call args into the Captors among the WithArgs declaration
+func (m *mockMethodStruct) captureArgs(args methodArgumentsList) {
+	if !m.hasArgsExpectation() {
+		return
+	}
+	for i, expected := range m.expectedArgsValues {
+		switch v := expected.(type) {
+		case *Captor:
+			v.capture(args[i])
+		case *variadicArgsMatcher:
+			slice := reflect.ValueOf(args[i])
+			for j, element := range v.elements {
+				if captor, ok := element.(*Captor); ok {
+					captor.capture(slice.Index(j).Interface())
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package monkeymock
+
+// Tests that we can only run from an internal perspective
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testInternalArguments struct {
+	suite.Suite
+}
+
+func TestInternalArguments(t *testing.T) {
+	suite.Run(t, new(testInternalArguments))
+}
+
+func (s *testInternalArguments) TestAnyMatchers() {
+	assert.True(s.T(), Any().Matches(nil))
+	assert.True(s.T(), Any().Matches(7))
+
+	assert.True(s.T(), AnyOfType[int]().Matches(7))
+	assert.False(s.T(), AnyOfType[int]().Matches("7"))
+	assert.False(s.T(), AnyOfType[int]().Matches(nil))
+	assert.True(s.T(), AnyOfType[error]().Matches(errors.New("oops")))
+	assert.True(s.T(), AnyOfType[error]().Matches(nil))
+	assert.True(s.T(), AnyOfType[*int]().Matches(nil))
+}
+
+func (s *testInternalArguments) TestEqualityMatchers() {
+	assert.True(s.T(), Eq(7).Matches(7))
+	assert.False(s.T(), Eq(7).Matches(int64(7)))
+	assert.False(s.T(), Eq([]int{7}).Matches([]int{7}), "non-comparable values never match Eq")
+	type holder struct{ V interface{} }
+	assert.False(s.T(), Eq(holder{V: []int{7}}).Matches(holder{V: []int{7}}), "non-comparable values held within interface fields never match Eq")
+	assert.True(s.T(), Eq(holder{V: 7}).Matches(holder{V: 7}))
+	assert.True(s.T(), Eq(nil).Matches(nil))
+
+	assert.True(s.T(), DeepEq([]int{7}).Matches([]int{7}))
+	assert.False(s.T(), DeepEq([]int{7}).Matches([]int{8}))
+}
+
+func (s *testInternalArguments) TestStringMatchers() {
+	assert.True(s.T(), Regexp("^user:[0-9]+$").Matches("user:42"))
+	assert.True(s.T(), Regexp("oops").Matches(errors.New("big oops")))
+	assert.False(s.T(), Regexp("^user:").Matches("group:42"))
+	assert.False(s.T(), Regexp("7").Matches(7))
+	assert.Panics(s.T(), func() { Regexp("(") })
+
+	assert.True(s.T(), HasPrefix("user:").Matches("user:42"))
+	assert.True(s.T(), HasPrefix("user:").Matches([]byte("user:42")))
+	assert.False(s.T(), HasPrefix("user:").Matches("group:42"))
+
+	assert.True(s.T(), Contains("er:4").Matches("user:42"))
+	assert.True(s.T(), Contains(2).Matches([]int{1, 2, 3}))
+	assert.True(s.T(), Contains("key").Matches(map[string]int{"key": 1}))
+	assert.False(s.T(), Contains(4).Matches([]int{1, 2, 3}))
+}
+
+func (s *testInternalArguments) TestMeasureMatchers() {
+	assert.True(s.T(), Len(3).Matches([]int{1, 2, 3}))
+	assert.True(s.T(), Len(3).Matches("abc"))
+	assert.False(s.T(), Len(3).Matches(3))
+
+	assert.True(s.T(), InDelta(1.0, 0.1).Matches(1.05))
+	assert.True(s.T(), InDelta(1.0, 0.1).Matches(1))
+	assert.False(s.T(), InDelta(1.0, 0.1).Matches(1.2))
+	assert.False(s.T(), InDelta(1.0, 0.1).Matches("1"))
+
+	now := time.Now()
+	later := now.Add(time.Second)
+	assert.True(s.T(), WithinDuration(now, 2*time.Second).Matches(later))
+	assert.True(s.T(), WithinDuration(now, 2*time.Second).Matches(&later))
+	assert.False(s.T(), WithinDuration(now, time.Millisecond).Matches(later))
+}
+
+func (s *testInternalArguments) TestErrorAndPredicateMatchers() {
+	wrapped := fmt.Errorf("wrapped: %w", io.EOF)
+	assert.True(s.T(), ErrorIs(io.EOF).Matches(wrapped))
+	assert.False(s.T(), ErrorIs(io.ErrUnexpectedEOF).Matches(wrapped))
+	assert.False(s.T(), ErrorIs(io.EOF).Matches("EOF"))
+
+	isEven := Satisfies(func(v int) bool { return v%2 == 0 })
+	assert.True(s.T(), isEven.Matches(4))
+	assert.False(s.T(), isEven.Matches(3))
+	assert.False(s.T(), isEven.Matches("4"))
+	assert.Equal(s.T(), "Satisfies(func(int) bool)", isEven.String())
+}
+
+func (s *testInternalArguments) TestCombinedMatchers() {
+	matcher := And(HasPrefix("user:"), Not(Contains("admin")))
+	assert.True(s.T(), matcher.Matches("user:42"))
+	assert.False(s.T(), matcher.Matches("user:admin"))
+	assert.Equal(s.T(), `And(HasPrefix("user:"), Not(Contains("admin")))`, matcher.String())
+
+	matcher = Or(Eq(1), Eq(2))
+	assert.True(s.T(), matcher.Matches(2))
+	assert.False(s.T(), matcher.Matches(3))
+}
+
+func (s *testInternalArguments) TestMatcherSpecificity() {
+	mock := Expect(&someExampleStruct{})
+	literal := mock.ToReceive("ExampleMethod").WithArgs("junk", 7).(*mockStruct).lastmockMethodStructPtr
+	partial := mock.ToReceive("ExampleMethod").WithArgs("junk", Any()).(*mockStruct).lastmockMethodStructPtr
+	wildcard := mock.ToReceive("ExampleMethod").WithArgs(Any(), Any()).(*mockStruct).lastmockMethodStructPtr
+	fallback := mock.ToReceive("ExampleMethod").WithAnyArgs().(*mockStruct).lastmockMethodStructPtr
+	assert.True(s.T(), literal.argsSpecificity() > partial.argsSpecificity())
+	assert.True(s.T(), partial.argsSpecificity() > wildcard.argsSpecificity())
+	assert.True(s.T(), wildcard.argsSpecificity() > fallback.argsSpecificity())
+	clearMockList()
+}
+
+func (s *testInternalArguments) TestVariadicArgsMatcher() {
+	matcher := &variadicArgsMatcher{elements: []interface{}{1, Any()}}
+	assert.True(s.T(), matcher.Matches([]int{1, 2}))
+	assert.True(s.T(), matcher.Matches([]interface{}{1, "two"}))
+	assert.False(s.T(), matcher.Matches([]int{1}))
+	assert.False(s.T(), matcher.Matches(1), "only slices match")
+	assert.False(s.T(), matcher.isLiteral())
+	assert.Equal(s.T(), "variadic(1 <int>, Any())", matcher.String())
+
+	empty := &variadicArgsMatcher{elements: []interface{}{}}
+	assert.True(s.T(), empty.Matches([]int(nil)))
+	assert.True(s.T(), empty.isLiteral())
+
+	mock := Expect(&someVariadicStruct{})
+	literal := mock.ToReceive("Log").WithArgs("f", 1).(*mockStruct).lastmockMethodStructPtr
+	partial := mock.ToReceive("Log").WithArgs("f", Any()).(*mockStruct).lastmockMethodStructPtr
+	assert.True(s.T(), literal.argsSpecificity() > partial.argsSpecificity())
+	assert.Equal(s.T(), "<string>, <...interface {}>", literal.stringifyArgTypes())
+	assert.Equal(s.T(), methodArgumentsList{"f", []interface{}{1, nil}}, literal.variadicCallArgs(methodArgumentsList{"f", 1, nil}))
+	clearMockList()
+}
+
+func (s *testInternalArguments) TestCaptor() {
+	captor := NewCaptor()
+	assert.True(s.T(), captor.Matches("anything"), "unbound captors match anything")
+	assert.Equal(s.T(), "Captor()", captor.String())
+
+	mock := Expect(&someExampleStruct{})
+	mockMethod := mock.ToReceive("ExampleMethod").WithArgs(captor, 7).(*mockStruct).lastmockMethodStructPtr
+	assert.Equal(s.T(), "Captor(<string>)", captor.String(), "WithArgs binds the parameter type")
+	assert.True(s.T(), captor.Matches("junk"))
+	assert.False(s.T(), captor.Matches(7))
+	assert.Empty(s.T(), captor.Values(), "matching alone captures nothing")
+	assert.Equal(s.T(), 2, mockMethod.argsSpecificity(), "captors rank as matchers")
+
+	mockMethod.captureArgs(methodArgumentsList{"junk", 7})
+	assert.Equal(s.T(), "junk", captor.Value())
+	assert.Panics(s.T(), func() {
+		Expect(&someExampleStruct{}).ToReceive("ExampleMethod").WithArgs("junk", captor)
+	}, "a captor cannot be bound to two parameter types")
+	assert.Panics(s.T(), func() {
+		Expect(&someExampleStruct{}).ToReceive("ExampleMethod").WithArgs(Or(Eq("junk"), NewCaptor()), 7)
+	}, "a nested captor would never capture")
+	assert.Panics(s.T(), func() {
+		Expect(&someExampleStruct{}).ToReceive("ExampleMethod").WithArgs(Any(), Not(And(Any(), NewCaptor())))
+	}, "captors are found at any depth")
+	assert.NotPanics(s.T(), func() {
+		Expect(&someExampleStruct{}).ToReceive("ExampleMethod").WithArgs(Or(Eq("junk"), HasPrefix("j")), 7)
+	})
+	clearMockList()
+}
+
+type someVariadicStruct struct{}
+
+func (s *someVariadicStruct) Log(format string, args ...interface{}) {}
+
+func (s *testInternalArguments) TestValueValidForType() {
+	intType, int8Type, int64Type, uintType := reflect.TypeOf(0), reflect.TypeOf(int8(0)), reflect.TypeOf(int64(0)), reflect.TypeOf(uint(0))
+	writerType := reflect.TypeOf((*io.Writer)(nil)).Elem()
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+	assert.True(s.T(), isValueValidForType(nil, reflect.TypeOf((*int)(nil))))
+	assert.True(s.T(), isValueValidForType(nil, errorType))
+	assert.False(s.T(), isValueValidForType(nil, intType))
+	assert.True(s.T(), isValueValidForType(new(bytes.Buffer), writerType))
+	assert.False(s.T(), isValueValidForType("buffer", writerType))
+	assert.True(s.T(), isValueValidForType(7, int64Type))
+	assert.True(s.T(), isValueValidForType(7, uintType))
+	assert.False(s.T(), isValueValidForType(-1, uintType), "sign would be lost")
+	assert.False(s.T(), isValueValidForType(300, int8Type), "value would be truncated")
+	assert.False(s.T(), isValueValidForType(7.5, intType), "fraction would be lost")
+	assert.False(s.T(), isValueValidForType("7", intType))
+	assert.False(s.T(), isValueValidForType(7, reflect.TypeOf("")), "ints are not converted into strings")
+	assert.False(s.T(), isValueValidForType("7", reflect.TypeOf([]byte(nil))))
+	assert.False(s.T(), isValueValidForType([]byte("7"), reflect.TypeOf("")))
+
+	type status string
+	type enabled bool
+	statusType, enabledType := reflect.TypeOf(status("")), reflect.TypeOf(enabled(false))
+	assert.True(s.T(), isValueValidForType("active", statusType))
+	assert.True(s.T(), isValueValidForType(status("active"), reflect.TypeOf("")))
+	assert.True(s.T(), isValueValidForType(true, enabledType))
+	assert.False(s.T(), isValueValidForType(true, statusType))
+	assert.Equal(s.T(), status("active"), valueOfType("active", statusType).Interface())
+	assert.Equal(s.T(), enabled(true), valueOfType(true, enabledType).Interface())
+
+	converted := convertValuesToTypes([]interface{}{7, nil, nil, Any()},
+		[]reflect.Type{int64Type, errorType, reflect.TypeOf((*int)(nil)), intType})
+	assert.Equal(s.T(), int64(7), converted[0])
+	assert.Nil(s.T(), converted[1])
+	assert.Equal(s.T(), (*int)(nil), converted[2])
+	assert.Equal(s.T(), "Any()", converted[3].(ArgumentMatcher).String())
+
+	assert.Equal(s.T(), int64Type, valueOfType(7, int64Type).Type())
+	assert.Equal(s.T(), errorType, valueOfType(nil, errorType).Type())
+}
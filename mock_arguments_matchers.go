@@ -0,0 +1,341 @@
+package monkeymock
+
+/*
+
+Argument matchers may be given to WithArgs in place of any literal argument
+value. Rather than requiring an exact match on the received argument, each
+matcher decides for itself whether the received argument is acceptable.
+
+	monkeymock.Expect(obj).
+		ToReceive("Get").
+		WithArgs(monkeymock.Any(), monkeymock.HasPrefix("user:")).
+		WithReturns(someUser, nil)
+
+Matchers can be combined with And, Or and Not. Custom matchers only need to
+implement the ArgumentMatcher interface.
+
+*/
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ArgumentMatcher is implemented by all argument matchers, including the built-in ones.
+// Any value implementing ArgumentMatcher that is given to WithArgs is treated as a
+// matcher rather than a literal value: it is exempt from the WithArgs signature type
+// check, Matches is consulted for every call dispatched to the method, and String is
+// used to describe the expectation within failure output.
+// Implement it for domain types that need custom equality.
+type ArgumentMatcher interface {
+	Matches(arg interface{}) bool // returns true if the given argument is acceptable
+	String() string               // human readable description, used within failure output
+}
+
+// generic matcher implementation, backed by a description and a match func
+type funcMatcher struct {
+	description string
+	matchFunc   func(arg interface{}) bool
+	nested      []ArgumentMatcher // the matchers combined by this one (And, Or, Not)
+}
+
+func (fm *funcMatcher) Matches(arg interface{}) bool {
+	return fm.matchFunc(arg)
+}
+
+func (fm *funcMatcher) String() string {
+	return fm.description
+}
+
+func newFuncMatcher(description string, matchFunc func(arg interface{}) bool) ArgumentMatcher {
+	return &funcMatcher{description: description, matchFunc: matchFunc}
+}
+
+func newCombinedMatcher(description string, nested []ArgumentMatcher, matchFunc func(arg interface{}) bool) ArgumentMatcher {
+	return &funcMatcher{description: description, matchFunc: matchFunc, nested: nested}
+}
+
+// returns the given value as an ArgumentMatcher, if it is one
+func asArgumentMatcher(value interface{}) (ArgumentMatcher, bool) {
+	matcher, ok := value.(ArgumentMatcher)
+	return matcher, ok
+}
+
+///////////////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// Any matches any argument value, including nil
+func Any() ArgumentMatcher {
+	return newFuncMatcher("Any()", func(arg interface{}) bool {
+		return true
+	})
+}
+
+// AnyOfType matches any argument value that is assignable to type T.
+// A nil argument matches when T is a nillable type (pointer, interface, slice, map, chan, func).
+func AnyOfType[T any]() ArgumentMatcher {
+	wantType := reflect.TypeOf((*T)(nil)).Elem()
+	return newFuncMatcher(fmt.Sprintf("AnyOfType[%s]()", wantType.String()), func(arg interface{}) bool {
+		if arg == nil {
+			return isNillableKind(wantType.Kind())
+		}
+		return reflect.TypeOf(arg).AssignableTo(wantType)
+	})
+}
+
+// Eq matches an argument that is of the same type as, and == to, the expected value.
+// Values of non-comparable types (or holding non-comparable values) never match; use DeepEq for those.
+// Within WithArgs (and WithReturns), the expected value is first converted to the parameter type,
+// the same way as a literal value (ie, Eq(7) for an int64 parameter, or Eq(nil) for a pointer).
+func Eq(expected interface{}) ArgumentMatcher {
+	return &eqMatcher{expected: expected}
+}
+
+type eqMatcher struct {
+	expected interface{}
+}
+
+func (em *eqMatcher) Matches(arg interface{}) (matched bool) {
+	if em.expected == nil || arg == nil {
+		return em.expected == nil && arg == nil
+	}
+	if reflect.TypeOf(em.expected) != reflect.TypeOf(arg) || !reflect.TypeOf(arg).Comparable() {
+		return false
+	}
+	// a comparable type may still hold a non-comparable value within an interface field, for which == panics
+	defer func() {
+		if recover() != nil {
+			matched = false
+		}
+	}()
+	return em.expected == arg
+}
+
+func (em *eqMatcher) String() string {
+	return fmt.Sprintf("Eq(%#v)", em.expected)
+}
+
+// returns the matcher with its expected value converted to the given type, when valid for it (see isValueValidForType)
+func (em *eqMatcher) ofType(t reflect.Type) ArgumentMatcher {
+	if !isValueValidForType(em.expected, t) {
+		return em
+	}
+	return &eqMatcher{expected: valueOfType(em.expected, t).Interface()}
+}
+
+// DeepEq matches an argument that is reflect.DeepEqual to the expected value
+func DeepEq(expected interface{}) ArgumentMatcher {
+	return newFuncMatcher(fmt.Sprintf("DeepEq(%#v)", expected), func(arg interface{}) bool {
+		return reflect.DeepEqual(expected, arg)
+	})
+}
+
+// Regexp matches a string-like argument (string, []byte, error or fmt.Stringer)
+// against the given regular expression. Panics at setup if the pattern is invalid.
+func Regexp(pattern string) ArgumentMatcher {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		panicInvalidArgumentMatcher("Regexp()", err.Error())
+	}
+	return newFuncMatcher(fmt.Sprintf("Regexp(%q)", pattern), func(arg interface{}) bool {
+		str, ok := stringLikeValue(arg)
+		return ok && re.MatchString(str)
+	})
+}
+
+// Contains matches an argument that contains the given element:
+// - a string-like argument containing the given substring
+// - a slice or array argument holding an item that is reflect.DeepEqual to the element
+// - a map argument holding a key that is reflect.DeepEqual to the element
+func Contains(element interface{}) ArgumentMatcher {
+	return newFuncMatcher(fmt.Sprintf("Contains(%#v)", element), func(arg interface{}) bool {
+		if str, ok := stringLikeValue(arg); ok {
+			substr, isString := element.(string)
+			return isString && strings.Contains(str, substr)
+		}
+		argValue := reflect.ValueOf(arg)
+		switch argValue.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < argValue.Len(); i++ {
+				if reflect.DeepEqual(argValue.Index(i).Interface(), element) {
+					return true
+				}
+			}
+		case reflect.Map:
+			for _, key := range argValue.MapKeys() {
+				if reflect.DeepEqual(key.Interface(), element) {
+					return true
+				}
+			}
+		}
+		return false
+	})
+}
+
+// HasPrefix matches a string-like argument that begins with the given prefix
+func HasPrefix(prefix string) ArgumentMatcher {
+	return newFuncMatcher(fmt.Sprintf("HasPrefix(%q)", prefix), func(arg interface{}) bool {
+		str, ok := stringLikeValue(arg)
+		return ok && strings.HasPrefix(str, prefix)
+	})
+}
+
+// Len matches a string, slice, array, map or chan argument of the given length
+func Len(length int) ArgumentMatcher {
+	return newFuncMatcher(fmt.Sprintf("Len(%d)", length), func(arg interface{}) bool {
+		argValue := reflect.ValueOf(arg)
+		switch argValue.Kind() {
+		case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+			return argValue.Len() == length
+		}
+		return false
+	})
+}
+
+// InDelta matches a numeric argument within delta of the expected value
+func InDelta(expected float64, delta float64) ArgumentMatcher {
+	return newFuncMatcher(fmt.Sprintf("InDelta(%v, %v)", expected, delta), func(arg interface{}) bool {
+		actual, ok := float64Value(arg)
+		if !ok {
+			return false
+		}
+		diff := actual - expected
+		return diff <= delta && diff >= -delta
+	})
+}
+
+// WithinDuration matches a time.Time (or *time.Time) argument within delta of the expected time
+func WithinDuration(expected time.Time, delta time.Duration) ArgumentMatcher {
+	return newFuncMatcher(fmt.Sprintf("WithinDuration(%s, %s)", expected.Format(time.RFC3339Nano), delta), func(arg interface{}) bool {
+		var actual time.Time
+		switch v := arg.(type) {
+		case time.Time:
+			actual = v
+		case *time.Time:
+			if v == nil {
+				return false
+			}
+			actual = *v
+		default:
+			return false
+		}
+		diff := actual.Sub(expected)
+		return diff <= delta && diff >= -delta
+	})
+}
+
+// ErrorIs matches an error argument for which errors.Is(arg, target) is true
+func ErrorIs(target error) ArgumentMatcher {
+	return newFuncMatcher(fmt.Sprintf("ErrorIs(%v)", target), func(arg interface{}) bool {
+		err, ok := arg.(error)
+		if !ok {
+			return arg == nil && target == nil
+		}
+		return errors.Is(err, target)
+	})
+}
+
+// Satisfies matches an argument assignable to T for which the given predicate returns true
+func Satisfies[T any](predicate func(T) bool) ArgumentMatcher {
+	wantType := reflect.TypeOf((*T)(nil)).Elem()
+	return newFuncMatcher(fmt.Sprintf("Satisfies(func(%s) bool)", wantType.String()), func(arg interface{}) bool {
+		if arg == nil {
+			if !isNillableKind(wantType.Kind()) {
+				return false
+			}
+			var zero T
+			return predicate(zero)
+		}
+		typed, ok := arg.(T)
+		if !ok {
+			return false
+		}
+		return predicate(typed)
+	})
+}
+
+///////////////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// And matches an argument accepted by every one of the given matchers
+func And(matchers ...ArgumentMatcher) ArgumentMatcher {
+	return newCombinedMatcher("And("+stringifyMatchersList(matchers)+")", matchers, func(arg interface{}) bool {
+		for _, matcher := range matchers {
+			if !matcher.Matches(arg) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Or matches an argument accepted by at least one of the given matchers
+func Or(matchers ...ArgumentMatcher) ArgumentMatcher {
+	return newCombinedMatcher("Or("+stringifyMatchersList(matchers)+")", matchers, func(arg interface{}) bool {
+		for _, matcher := range matchers {
+			if matcher.Matches(arg) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Not matches an argument rejected by the given matcher
+func Not(matcher ArgumentMatcher) ArgumentMatcher {
+	return newCombinedMatcher("Not("+matcher.String()+")", []ArgumentMatcher{matcher}, func(arg interface{}) bool {
+		return !matcher.Matches(arg)
+	})
+}
+
+///////////////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+func stringifyMatchersList(matchers []ArgumentMatcher) string {
+	descriptions := make([]string, len(matchers))
+	for i, v := range matchers {
+		descriptions[i] = v.String()
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// returns the string form of string-like values (string, []byte, error, fmt.Stringer)
+func stringLikeValue(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case []byte:
+		return string(v), true
+	case error:
+		return v.Error(), true
+	case fmt.Stringer:
+		return v.String(), true
+	}
+	return "", false
+}
+
+// returns the given numeric value as a float64
+func float64Value(value interface{}) (float64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+func isNillableKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func:
+		return true
+	}
+	return false
+}
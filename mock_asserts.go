@@ -1,27 +1,201 @@
 package monkeymock
 
 import (
-	"testing"
+	"fmt"
+	"strings"
 )
 
 type mockAssertions interface {
-	AssertExpectations(t *testing.T, opts ...interface{})
+	AssertExpectations(t TestingT, opts ...AssertOption)
+}
+
+// AssertOption customizes the behaviour of AssertExpectations
+type AssertOption func(*assertOptions)
+
+type assertOptions struct {
+	keepExpectations bool   // preserve Mocks and partial intercepts after verification
+	failFast         bool   // stop the test at the first Mock with failures
+	verbose          bool   // log every call received, even when passing
+	onlyMocks        []Mock // verify only these Mocks, rather than all of them
+}
+
+// KeepExpectations preserves every Mock, expectation and partial intercept after a call
+// to the package level AssertExpectations, rather than clearing them (the default).
+func KeepExpectations() AssertOption {
+	return func(o *assertOptions) {
+		o.keepExpectations = true
+	}
+}
+
+// FailFast stops the test (via t.Fatalf) at the first Mock with unmet expectations, rather
+// than reporting the failures of every Mock and carrying on (via t.Errorf).
+func FailFast() AssertOption {
+	return func(o *assertOptions) {
+		o.failFast = true
+	}
+}
+
+// Verbose logs (via t.Logf) every call received by each verified Mock, along with its args
+// and returns, even when all expectations have been met.
+func Verbose() AssertOption {
+	return func(o *assertOptions) {
+		o.verbose = true
+	}
+}
+
+// OnlyMocks limits the package level AssertExpectations to the given Mocks. Unless
+// KeepExpectations() is also given, only these Mocks (and the partial intercepts used only
+// by them) are cleared afterwards; every other Mock is left untouched.
+func OnlyMocks(mocks ...Mock) AssertOption {
+	return func(o *assertOptions) {
+		o.onlyMocks = append(o.onlyMocks, mocks...)
+	}
+}
+
+func newAssertOptions(opts []AssertOption) *assertOptions {
+	options := new(assertOptions)
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+// returns the Mocks of the given Controller selected for verification, in Mock List order
+func (o *assertOptions) selectMocks(c *Controller) [](*mockStruct) {
+	var retVal [](*mockStruct)
+	for _, v := range c.mocks() {
+		if o.onlyMocks != nil && !containsMock(o.onlyMocks, v) {
+			continue
+		}
+		retVal = append(retVal, v.(*mockStruct))
+	}
+	return retVal
+}
+
+func containsMock(mocks []Mock, mock Mock) bool {
+	for _, v := range mocks {
+		if v == mock {
+			return true
+		}
+	}
+	return false
+}
+
+// reports the rendered failures (if any) to the test, per the options
+func (o *assertOptions) reportFailures(t TestingT, report string) {
+	t.Helper()
+	if report == "" {
+		return
+	}
+	if o.failFast {
+		tFatal(t, report)
+		return
+	}
+	tFail(t, report)
+}
+
+// collects assertion failure messages
+type assertFailures []string
+
+func (f *assertFailures) add(failureMessage string) {
+	*f = append(*f, failureMessage)
 }
 
 // AssertExpectations for a single Mock.
-func (m *mockStruct) AssertExpectations(t *testing.T, opts ...interface{}) {
+// The FailFast() and Verbose() options are honoured; the Mock is never cleared.
+func (m *mockStruct) AssertExpectations(t TestingT, opts ...AssertOption) {
 	t.Helper()
-	m.assertMethods(t)
+	options := newAssertOptions(opts)
+	report := m.assertExpectations(t, options, fmt.Sprintf("Mock <%s>", m.gRefObjectTypeName()))
+	options.reportFailures(t, report)
+}
+
+// verifies the Mock, returning its rendered failures (or an empty string)
+func (m *mockStruct) assertExpectations(t TestingT, options *assertOptions, header string) string {
+	t.Helper()
+	if options.verbose {
+		tLog(t, header+" calls:\n"+stringifyMockCalls(m))
+	}
+	var failures assertFailures
+	m.assertMethods(&failures)
+	if len(failures) == 0 {
+		return ""
+	}
+	return stringifyMockFailures(header, failures)
 }
 
 /// General module-level assertions
 
 // AssertExpectations across all Mock instances.
-func AssertExpectations(t *testing.T, opts ...interface{}) {
-	//
+// Every Mock created via Expect is verified, and any failures are reported grouped by Mock.
+// Afterwards all Mocks, expectations and partial intercepts are cleared, so the next
+// example starts from a clean slate. Pass KeepExpectations() to preserve them instead.
+// See also FailFast(), Verbose() and OnlyMocks().
+func AssertExpectations(t TestingT, opts ...AssertOption) {
+	t.Helper()
+	gDefaultController.assertExpectations(t, newAssertOptions(opts))
+}
+
+// verifies the Mocks of the Controller, then clears them (per the options)
+func (c *Controller) assertExpectations(t TestingT, options *assertOptions) {
+	t.Helper()
+	mocks := options.selectMocks(c)
+
+	report := ""
+	for i, mock := range mocks {
+		header := fmt.Sprintf("Mock #%d of %d <%s>", i+1, len(mocks), mock.gRefObjectTypeName())
+		report += mock.assertExpectations(t, options, header)
+		if report != "" && options.failFast {
+			break
+		}
+	}
+
+	if !options.keepExpectations {
+		if options.onlyMocks != nil {
+			for _, mock := range mocks {
+				releaseMock(mock)
+			}
+		} else {
+			c.reset()
+		}
+	}
+	options.reportFailures(t, report)
 }
 
 // ClearExpectations resets the board, removing all existing expectations for every Mock.
-func ClearExpectations(t *testing.T, opts ...interface{}) {
-	//
+// Every partial intercept is unpatched (bar those still relied upon by another Controller),
+// so methods of objects previously handed out by AsPartial resume their original behaviour.
+// Nothing is verified; use AssertExpectations for that.
+func ClearExpectations(t TestingT) {
+	t.Helper()
+	gDefaultController.reset()
+}
+
+// renders the failures of a single Mock as one group, under the given header
+func stringifyMockFailures(header string, failures assertFailures) string {
+	failureCount := "1 failure"
+	if len(failures) != 1 {
+		failureCount = fmt.Sprintf("%d failures", len(failures))
+	}
+	report := fmt.Sprintf("%s: %s\n", header, failureCount)
+	for _, failure := range failures {
+		report += "    " + strings.ReplaceAll(strings.TrimRight(failure, "\n"), "\n", "\n    ") + "\n"
+	}
+	return report
+}
+
+// renders every call received by each mockMethod of the given Mock
+func stringifyMockCalls(m *mockStruct) string {
+	mockMethods := m.mockMethods()
+	if len(mockMethods) == 0 {
+		return "          (no methods declared)\n"
+	}
+	withCallRecordsLock(func() {
+		mockMethods = snapshotMockMethods(mockMethods)
+	})
+	calls := ""
+	for _, v := range mockMethods {
+		calls += fmt.Sprintf("method  : %s\n%s", stringifyMethodName(v), stringifyMethodCalls(v))
+	}
+	return calls
 }
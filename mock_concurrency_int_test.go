@@ -0,0 +1,203 @@
+package monkeymock
+
+// Concurrent stress tests; most useful when run with: go test -race -gcflags=all=-l
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/eshork/monkeymock/testsupports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	stressGoroutines = 32
+	stressCalls      = 50
+)
+
+func TestInternalConcurrency(t *testing.T) {
+	suite.Run(t, new(testInternalConcurrency))
+}
+
+type testInternalConcurrency struct {
+	suite.Suite
+}
+
+func (s *testInternalConcurrency) AfterTest(_, _ string) {
+	ClearExpectations(s.T())
+}
+
+// runs fn from stressGoroutines goroutines at once, each stressCalls times
+func runConcurrently(fn func(goroutine int, call int)) {
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for g := 0; g < stressGoroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			<-start
+			for c := 0; c < stressCalls; c++ {
+				fn(g, c)
+			}
+		}(g)
+	}
+	close(start)
+	wg.Wait()
+}
+
+func (s *testInternalConcurrency) TestConcurrentCallsAreAllRecorded() {
+	mock := Expect(&someExampleStruct{}).ToReceive("ExampleMethod").WithAnyArgs().
+		Times(stressGoroutines * stressCalls).WithReturns(3)
+	runConcurrently(func(g int, c int) {
+		mock.Call("ExampleMethod", "junk", c)
+	})
+	fakeT := &FakeT{}
+	mock.AssertExpectations(fakeT)
+	assert.False(s.T(), fakeT.Failed(), "%v", fakeT.Errors)
+}
+
+func (s *testInternalConcurrency) TestConcurrentDispatchHonoursCallCounts() {
+	mock := Expect(&someExampleStruct{})
+	once := mock.ToReceive("ExampleMethod").WithAnyArgs().Once().WithReturns(1).Expectation()
+	fallback := mock.ToReceive("ExampleMethod").WithAnyArgs().WithReturns(2).Expectation()
+	var onceCalls, fallbackCalls int
+	var lock sync.Mutex
+	runConcurrently(func(g int, c int) {
+		ret := mock.Call("ExampleMethod", "junk", c)
+		lock.Lock()
+		defer lock.Unlock()
+		if ret[0] == 1 {
+			onceCalls++
+		} else {
+			fallbackCalls++
+		}
+	})
+	assert.Equal(s.T(), 1, onceCalls)
+	assert.Equal(s.T(), stressGoroutines*stressCalls-1, fallbackCalls)
+	assert.Equal(s.T(), 1, once.expectationRef().calledCount())
+	assert.Equal(s.T(), stressGoroutines*stressCalls-1, fallback.expectationRef().calledCount())
+}
+
+func (s *testInternalConcurrency) TestConcurrentPartialCalls() {
+	exampleStruct := &ExamplePartialInternalStruct{}
+	var _ = Expect(exampleStruct).ToReceive("ExamplePublicMethod").WithAnyArgs().
+		Times(stressGoroutines * stressCalls).WithReturns(100).AsPartial()
+	runConcurrently(func(g int, c int) {
+		assert.Equal(s.T(), 100, exampleStruct.ExamplePublicMethod("junk", c))
+	})
+	fakeT := &FakeT{}
+	AssertExpectations(fakeT)
+	assert.False(s.T(), fakeT.Failed(), "%v", fakeT.Errors)
+}
+
+func (s *testInternalConcurrency) TestConcurrentPartialCallsOriginal() {
+	exampleStruct := &ExamplePartialInternalStruct{}
+	untouchedStruct := &ExamplePartialInternalStruct{}
+	var _ = Expect(exampleStruct).ToReceive("ExamplePublicMethod").WithAnyArgs().AndCallsOriginal().AsPartial()
+	runConcurrently(func(g int, c int) {
+		assert.Equal(s.T(), c, exampleStruct.ExamplePublicMethod("junk", c))
+		assert.Equal(s.T(), c, untouchedStruct.ExamplePublicMethod("junk", c))
+	})
+	require.Len(s.T(), interceptRecords, 1)
+	for _, record := range interceptRecords {
+		assert.Zero(s.T(), record.liftDepth, "every lifted patch should be restored")
+	}
+	assert.Equal(s.T(), 100, func() int {
+		var _ = Expect(exampleStruct).ToReceive("ExamplePublicMethod").WithArgs("mocked", 1).WithReturns(100).AsPartial()
+		return exampleStruct.ExamplePublicMethod("mocked", 1)
+	}(), "intercept should still be in place")
+}
+
+func (s *testInternalConcurrency) TestOriginalGrowingTheStack() {
+	untouchedStruct := &ExamplePartialInternalStruct{}
+	spiedStruct := Spy(&ExamplePartialInternalStruct{}).(*ExamplePartialInternalStruct)
+	runConcurrently(func(g int, c int) {
+		done := make(chan int)
+		go func() { done <- untouchedStruct.ExampleDeepStackMethod(c) }() // fresh goroutines start on a small stack
+		assert.Equal(s.T(), c, <-done)
+		go func() { done <- spiedStruct.ExampleDeepStackMethod(c) }()
+		assert.Equal(s.T(), c, <-done)
+	})
+
+	// concurrent calls may reach the original while another goroutine runs it (see AsPartial),
+	// so recording is only checked for a single call
+	recordedStruct := Spy(&ExamplePartialInternalStruct{}).(*ExamplePartialInternalStruct)
+	done := make(chan int)
+	go func() { done <- recordedStruct.ExampleDeepStackMethod(7) }()
+	assert.Equal(s.T(), 7, <-done)
+	fakeT := &FakeT{}
+	assert.True(s.T(), AssertReceived(fakeT, recordedStruct, "ExampleDeepStackMethod").Once().Passed(), "%v", fakeT.Errors)
+}
+
+func (s *testInternalConcurrency) TestMatchersMayCallMocks() {
+	dep := Expect(&someExampleStruct{}).ToReceive("ExampleMethod").WithAnyArgs().WithReturns(1)
+	allowed := Satisfies(func(v string) bool { return dep.Call("ExampleMethod", v, 0)[0] == 1 })
+	mock := Expect(&someExampleStruct{}).ToReceive("ExampleMethod").WithArgs(allowed, 7).WithReturns(2)
+
+	withTimeout(s.T(), func() {
+		assert.Equal(s.T(), []interface{}{2}, mock.Call("ExampleMethod", "junk", 7))
+		assert.Panics(s.T(), func() { mock.Call("ExampleMethod", "junk", 8) })
+		fakeT := &FakeT{}
+		mock.AssertExpectations(fakeT)
+		require.Len(s.T(), fakeT.Errors, 1)
+		assert.Contains(s.T(), fakeT.Errors[0], "Unexpected calls")
+	})
+	assert.Equal(s.T(), 3, dep.CallCount("ExampleMethod"), "the matcher should run on dispatch, and again for the closest match")
+}
+
+// runs fn, failing the test (rather than hanging) if it does not complete in time
+func withTimeout(t *testing.T, fn func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out; deadlocked?")
+	}
+}
+
+func (s *testInternalConcurrency) TestParallelControllers() {
+	s.T().Run("group", func(t *testing.T) {
+		for g := 0; g < stressGoroutines; g++ {
+			g := g
+			t.Run("", func(t *testing.T) {
+				t.Parallel()
+				ctrl := NewController(t)
+				exampleStruct := &ExamplePartialInternalStruct{}
+				var _ = ctrl.Expect(exampleStruct).ToReceive("ExamplePublicMethod").WithAnyArgs().
+					Times(stressCalls).WithReturns(g).AsPartial()
+				for c := 0; c < stressCalls; c++ {
+					assert.Equal(t, g, exampleStruct.ExamplePublicMethod("junk", c))
+				}
+			})
+		}
+	})
+	assert.Equal(s.T(), [](*Controller){gDefaultController}, gControllers)
+	assert.Zero(s.T(), len(interceptRecords))
+}
+
+func (s *testInternalConcurrency) TestReentrantMutex() {
+	r := newReentrantMutex()
+	r.Lock()
+	r.Lock() // same goroutine may lock again
+	locked := make(chan struct{})
+	go func() {
+		r.Lock()
+		close(locked)
+		r.Unlock()
+	}()
+	r.Unlock()
+	select {
+	case <-locked:
+		s.Fail("another goroutine acquired the lock while still held")
+	default:
+	}
+	r.Unlock()
+	<-locked
+}
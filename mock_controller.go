@@ -0,0 +1,105 @@
+package monkeymock
+
+import (
+	"sync"
+)
+
+// Controller owns a set of Mocks, along with their verification and cleanup.
+//
+// The package level functions (Expect, AssertExpectations, ClearExpectations) operate on a
+// default Controller shared by the whole test binary. Tests using t.Parallel() should each
+// create their own Controller instead, so they neither see nor verify each other's Mocks:
+//
+//	func TestSomething(t *testing.T) {
+//	  t.Parallel()
+//	  ctrl := monkeymock.NewController(t)
+//	  ctrl.Expect(yourObj).ToReceive("method").Once().WithReturns(7).AsPartial()
+//	  ...
+//	} // expectations are verified, and intercepts released, when the test ends
+//
+// Partial intercepts patch a method for every instance of a type, and so are shared between
+// Controllers. Each intercepted call is routed to whichever Mock targets the receiving object,
+// and an intercept is removed once no Mock (of any Controller) still relies upon it.
+type Controller struct {
+	t        TestingT // the owning test, or nil for the default Controller
+	mockList []Mock   // every Mock created via this Controller, in creation order
+}
+
+// the Controller behind the package level functions
+var gDefaultController = new(Controller)
+
+// every active Controller, consulted by partial intercepts (the default Controller is always first)
+var gControllers = [](*Controller){gDefaultController}
+
+// guards gControllers and the MockList of every Controller
+var gRegistryLock sync.RWMutex
+
+// returns a snapshot of every Mock of every active Controller
+func allMocks() []Mock {
+	gRegistryLock.RLock()
+	defer gRegistryLock.RUnlock()
+	var retVal []Mock
+	for _, c := range gControllers {
+		retVal = append(retVal, c.mockList...)
+	}
+	return retVal
+}
+
+// NewController creates a Controller bound to the lifetime of the given test (or benchmark,
+// or fuzz target). When the test ends, every Mock of the Controller is verified via
+// AssertExpectations, then dropped along with its partial intercepts.
+func NewController(t TestingT) *Controller {
+	t.Helper()
+	c := &Controller{t: t}
+	gRegistryLock.Lock()
+	gControllers = append(gControllers, c)
+	gRegistryLock.Unlock()
+	t.Cleanup(func() {
+		t.Helper()
+		c.assertExpectations(t, newAssertOptions(nil))
+		c.close()
+	})
+	return c
+}
+
+// Expect begins defining a new expectation, owned by this Controller. See the package level Expect.
+func (c *Controller) Expect(refObject interface{}) Mock {
+	validateIsMockableObjectRef(refObject)
+	mock := new(mockStruct)          // every Expect is a new assert condition...
+	mock.mockedObjectRef = refObject // store a reference to the original object/interface
+	mock.controller = c              // remember who owns it
+	c.appendToMockList(mock)         // throw it onto the FIFO stack...
+	return mock                      // make condition stacking easy...
+}
+
+// AssertExpectations across all Mocks of this Controller, reporting to the test given to
+// NewController. See the package level AssertExpectations for the available options.
+func (c *Controller) AssertExpectations(opts ...AssertOption) {
+	c.t.Helper()
+	c.assertExpectations(c.t, newAssertOptions(opts))
+}
+
+// ClearExpectations removes every Mock of this Controller, along with the partial intercepts
+// no other Controller still relies upon. Nothing is verified.
+func (c *Controller) ClearExpectations() {
+	c.reset()
+}
+
+// drops every Mock of this Controller, and every partial intercept left unused
+func (c *Controller) reset() {
+	c.clearMockList()
+	clearUnusedPartialObjectMethodIntercepts()
+}
+
+// resets the Controller and removes it from the active Controllers
+func (c *Controller) close() {
+	gRegistryLock.Lock()
+	for i, v := range gControllers {
+		if v == c {
+			gControllers = append(gControllers[:i:i], gControllers[i+1:]...)
+			break
+		}
+	}
+	gRegistryLock.Unlock()
+	c.reset()
+}
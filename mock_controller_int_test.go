@@ -0,0 +1,106 @@
+package monkeymock
+
+import (
+	"testing"
+
+	. "github.com/eshork/monkeymock/testsupports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestInternalController(t *testing.T) {
+	suite.Run(t, new(testInternalController))
+}
+
+type testInternalController struct {
+	suite.Suite
+}
+
+func (s *testInternalController) AfterTest(_, _ string) {
+	ClearExpectations(s.T())
+}
+
+func (s *testInternalController) TestControllersOwnTheirMocks() {
+	fakeA, fakeB := &FakeT{}, &FakeT{}
+	ctrlA, ctrlB := NewController(fakeA), NewController(fakeB)
+	ctrlA.Expect(&ExamplePartialInternalStruct{}).ToReceive("ExamplePublicMethod").Once()
+	ctrlB.Expect(&ExamplePartialInternalStruct{}).ToReceive("ExamplePublicMethod").Never()
+	assert.Zero(s.T(), sizeOfMockList(), "default Controller should be untouched")
+	assert.Equal(s.T(), 1, ctrlA.sizeOfMockList())
+	assert.Equal(s.T(), 1, ctrlB.sizeOfMockList())
+
+	ctrlB.AssertExpectations()
+	assert.False(s.T(), fakeB.Failed(), "should not verify the Mocks of another Controller")
+	ctrlA.AssertExpectations()
+	assert.True(s.T(), fakeA.Failed())
+	assert.Zero(s.T(), ctrlA.sizeOfMockList())
+
+	fakeA.RunCleanups()
+	fakeB.RunCleanups()
+	assert.Equal(s.T(), [](*Controller){gDefaultController}, gControllers, "closed Controllers should be removed")
+}
+
+func (s *testInternalController) TestControllerVerifiesOnCleanup() {
+	fakeT := &FakeT{}
+	ctrl := NewController(fakeT)
+	ctrl.Expect(&ExamplePartialInternalStruct{}).ToReceive("ExamplePublicMethod").Once()
+	fakeT.RunCleanups()
+	require.Len(s.T(), fakeT.Errors, 1)
+	assert.Contains(s.T(), fakeT.Errors[0], "ExamplePublicMethod")
+	assert.Zero(s.T(), ctrl.sizeOfMockList())
+}
+
+func (s *testInternalController) TestControllersShareIntercepts() {
+	fakeA, fakeB := &FakeT{}, &FakeT{}
+	ctrlA, ctrlB := NewController(fakeA), NewController(fakeB)
+	objA, objB := &ExamplePartialInternalStruct{}, &ExamplePartialInternalStruct{}
+	var _ = ctrlA.Expect(objA).ToReceive("ExamplePublicMethod").WithReturns(100).AsPartial()
+	var _ = ctrlB.Expect(objB).ToReceive("ExamplePublicMethod").WithReturns(200).AsPartial()
+	assert.Equal(s.T(), 100, objA.ExamplePublicMethod("junk", 7))
+	assert.Equal(s.T(), 200, objB.ExamplePublicMethod("junk", 7))
+
+	fakeA.RunCleanups()
+	assert.False(s.T(), fakeA.Failed())
+	assert.Equal(s.T(), 7, objA.ExamplePublicMethod("junk", 7), "released object should run the original")
+	assert.Equal(s.T(), 200, objB.ExamplePublicMethod("junk", 7), "intercept should remain for the other Controller")
+
+	ClearExpectations(s.T())
+	assert.Equal(s.T(), 200, objB.ExamplePublicMethod("junk", 7), "default Controller should not clear intercepts in use")
+
+	fakeB.RunCleanups()
+	assert.Zero(s.T(), len(interceptRecords))
+	assert.Equal(s.T(), 7, objB.ExamplePublicMethod("junk", 7))
+}
+
+func (s *testInternalController) TestClosingControllersKeepsCallOrder() {
+	mock := Expect(&ExamplePartialInternalStruct{})
+	first := mock.ToReceive("ExamplePublicMethod").WithAnyArgs().WithReturns(1).Expectation()
+	second := mock.ToReceive("ExamplePublicMethod3").WithAnyArgs().WithReturns(3).Expectation()
+	InOrder(first, second)
+	mock.Call("ExamplePublicMethod", "junk", 7)
+
+	fakeCtrlT := &FakeT{}
+	var _ = NewController(fakeCtrlT)
+	fakeCtrlT.RunCleanups()
+
+	mock.Call("ExamplePublicMethod3", "junk", 7)
+	fakeT := &FakeT{}
+	AssertExpectations(fakeT)
+	assert.False(s.T(), fakeT.Failed(), "%v", fakeT.Errors)
+}
+
+func (s *testInternalController) TestControllerWithSubtests() {
+	s.T().Run("group", func(t *testing.T) {
+		for _, n := range []int{100, 200, 300} {
+			n := n
+			t.Run("", func(t *testing.T) {
+				ctrl := NewController(t)
+				ctrl.Expect(&someExampleStruct{}).ToReceive("ExampleMethod").Once().WithAnyArgs().WithReturns(n)
+				assert.Equal(t, 1, ctrl.sizeOfMockList())
+				assert.Equal(t, []interface{}{n}, []interface{}(ctrl.mockList[0].Call("ExampleMethod", "junk", 1)))
+			})
+		}
+	})
+	assert.Equal(s.T(), [](*Controller){gDefaultController}, gControllers)
+}
@@ -3,7 +3,12 @@ package monkeymock_test
 // Tests that we can run from an external perspective
 
 import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit"
 	"github.com/eshork/monkeymock"
@@ -27,6 +32,62 @@ func (ex *ExampleExternalStruct) ExamplePublicMethod(trash1 string, trash2 int)
 	return trash2
 }
 
+func (ex *ExampleExternalStruct) ExampleOtherMethod(trash1 string) string {
+	return trash1
+}
+
+func (ex *ExampleExternalStruct) ExampleVoidMethod() {}
+
+func (ex *ExampleExternalStruct) ExampleErrorMethod(key string) (int, error) {
+	return len(key), nil
+}
+
+func (ex *ExampleExternalStruct) ExampleVariadicMethod(format string, args ...interface{}) string {
+	return fmt.Sprintf(format, args...)
+}
+
+func (ex *ExampleExternalStruct) ExampleWriterMethod(w io.Writer, n int64) error {
+	return nil
+}
+
+func (ex *ExampleExternalStruct) ExampleLookupMethod(parent *ExampleExternalStruct, tags map[string]int) *ExampleExternalStruct {
+	return parent
+}
+
+func (ex *ExampleExternalStruct) ExampleSumMethod(values ...int) int {
+	sum := 0
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
+func (ex *ExampleExternalStruct) ExampleDecodeMethod(v interface{}) error {
+	return nil
+}
+
+func (ex *ExampleExternalStruct) ExampleScanMethod(dest ...interface{}) error {
+	return nil
+}
+
+func (ex *ExampleExternalStruct) ExampleFillMethod(key string, out *int, buf []byte, tags map[string]int) int {
+	return 0
+}
+
+// custom argument matcher: matches strings case-insensitively
+type caseInsensitiveMatcher struct {
+	expected string
+}
+
+func (cm caseInsensitiveMatcher) Matches(arg interface{}) bool {
+	str, ok := arg.(string)
+	return ok && strings.EqualFold(str, cm.expected)
+}
+
+func (cm caseInsensitiveMatcher) String() string {
+	return fmt.Sprintf("EqualFold(%q)", cm.expected)
+}
+
 func TestMockExpectationBuilder(t *testing.T) {
 	suite.Run(t, new(testMockExpectationBuilder))
 }
@@ -183,6 +244,713 @@ func (s *testMockExpectationBuilder) TestWithReturnsOverridesActual() {
 	}
 }
 
+func (s *testMockExpectationBuilder) TestMultipleToReceiveOnSingleMock() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj)
+	assert.NotPanics(s.T(), func() {
+		mock.ToReceive("ExamplePublicMethod").Twice().WithArgs("taco", 7).WithReturns(19)
+		mock.ToReceive("ExampleOtherMethod").Once().WithReturns("burrito")
+		mock.ToReceive("ExampleVoidMethod").Never()
+	})
+
+	assert.Equal(s.T(), []interface{}{19}, mock.Call("ExamplePublicMethod", "taco", 7))
+	assert.Equal(s.T(), []interface{}{19}, mock.Call("ExamplePublicMethod", "taco", 7))
+	assert.Equal(s.T(), []interface{}{"burrito"}, mock.Call("ExampleOtherMethod", "nachos"))
+	mock.AssertExpectations(s.fakeT)
+	assert.False(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestMultipleToReceiveCountsAreIndependent() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj)
+	mock.ToReceive("ExamplePublicMethod").Once().WithReturns(19)
+	mock.ToReceive("ExampleOtherMethod").Once().WithReturns("burrito")
+	mock.Call("ExamplePublicMethod", "taco", 7)
+	mock.Call("ExamplePublicMethod", "taco", 7)
+	mock.AssertExpectations(s.fakeT)
+	assert.True(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestCallDispatchesOnMatchingArgs() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj)
+	mock.ToReceive("ExamplePublicMethod").WithAnyArgs().WithReturns(1)
+	mock.ToReceive("ExamplePublicMethod").WithArgs("taco", 7).Once().WithReturns(2)
+	mock.ToReceive("ExamplePublicMethod").WithArgs("burrito", 7).Once().WithReturns(3)
+
+	assert.Equal(s.T(), []interface{}{3}, mock.Call("ExamplePublicMethod", "burrito", 7))
+	assert.Equal(s.T(), []interface{}{2}, mock.Call("ExamplePublicMethod", "taco", 7))
+	assert.Equal(s.T(), []interface{}{1}, mock.Call("ExamplePublicMethod", "taco", 8))
+	mock.AssertExpectations(s.fakeT)
+	assert.False(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestCallPrefersUnsaturatedMatchingArgs() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj)
+	mock.ToReceive("ExamplePublicMethod").WithArgs("taco", 7).Once().WithReturns(2)
+	mock.ToReceive("ExamplePublicMethod").WithArgs("taco", 7).Once().WithReturns(3)
+
+	assert.Equal(s.T(), []interface{}{2}, mock.Call("ExamplePublicMethod", "taco", 7))
+	assert.Equal(s.T(), []interface{}{3}, mock.Call("ExamplePublicMethod", "taco", 7))
+	mock.AssertExpectations(s.fakeT)
+	assert.False(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestCallWithUnmatchedArgsPanics() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj)
+	mock.ToReceive("ExamplePublicMethod").WithArgs("taco", 7).WithReturns(2)
+	assert.Panics(s.T(), func() {
+		mock.Call("ExamplePublicMethod", "burrito", 7)
+	})
+}
+
+func (s *testMockExpectationBuilder) TestWithArgsAcceptsMatchers() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj)
+	assert.NotPanics(s.T(), func() {
+		mock.ToReceive("ExamplePublicMethod").WithArgs(monkeymock.HasPrefix("ta"), monkeymock.Any()).WithReturns(2)
+		mock.ToReceive("ExamplePublicMethod").WithArgs("taco", monkeymock.AnyOfType[int]()).WithReturns(3)
+	})
+	assert.Equal(s.T(), []interface{}{3}, mock.Call("ExamplePublicMethod", "taco", 7))
+	assert.Equal(s.T(), []interface{}{2}, mock.Call("ExamplePublicMethod", "tamale", 7))
+	assert.Panics(s.T(), func() {
+		mock.Call("ExamplePublicMethod", "burrito", 7)
+	})
+}
+
+func (s *testMockExpectationBuilder) TestEqMatcherConvertsToParamType() {
+	buffer := new(bytes.Buffer)
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).
+		ToReceive("ExampleWriterMethod").WithArgs(monkeymock.Eq(buffer), monkeymock.Eq(7)).WithReturns(nil).
+		ToReceive("ExampleFillMethod").WithArgs("key", monkeymock.Eq(nil), monkeymock.Any(), monkeymock.Any()).WithReturns(1)
+	assert.Equal(s.T(), []interface{}{nil}, mock.Call("ExampleWriterMethod", buffer, int64(7)), "Eq(7) should match an int64 parameter")
+	assert.Equal(s.T(), []interface{}{1}, mock.Call("ExampleFillMethod", "key", nil, nil, nil), "Eq(nil) should match a nil pointer")
+	assert.Panics(s.T(), func() {
+		out := 0
+		mock.Call("ExampleFillMethod", "key", &out, nil, nil)
+	})
+}
+
+func (s *testMockExpectationBuilder) TestWithArgsAcceptsCustomMatchers() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj)
+	assert.NotPanics(s.T(), func() {
+		mock.ToReceive("ExamplePublicMethod").WithArgs(caseInsensitiveMatcher{"TACO"}, 7).Once().WithReturns(2)
+	})
+	assert.Equal(s.T(), []interface{}{2}, mock.Call("ExamplePublicMethod", "taco", 7))
+	assert.Panics(s.T(), func() {
+		mock.Call("ExamplePublicMethod", "burrito", 7)
+	})
+}
+
+func (s *testMockExpectationBuilder) TestWithArgsMismatchDescribesCustomMatchers() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj).ToReceive("ExamplePublicMethod")
+	panicMsg := ""
+	func() {
+		defer func() { panicMsg = fmt.Sprint(recover()) }()
+		mock.WithArgs(caseInsensitiveMatcher{"TACO"}, "7")
+	}()
+	assert.Contains(s.T(), panicMsg, `EqualFold("TACO")`)
+}
+
+func (s *testMockExpectationBuilder) TestAndCallsFuncComputesReturns() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj)
+	mock.ToReceive("ExamplePublicMethod").Twice().AndCallsFunc(func(trash1 string, trash2 int) int {
+		return len(trash1) * trash2
+	})
+	assert.Equal(s.T(), []interface{}{8}, mock.Call("ExamplePublicMethod", "taco", 2))
+	assert.Equal(s.T(), []interface{}{21}, mock.Call("ExamplePublicMethod", "burrito", 3))
+	mock.AssertExpectations(s.fakeT)
+	assert.False(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestAndCallsFuncWithMismatchedSignaturePanics() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj).ToReceive("ExamplePublicMethod")
+	assert.Panics(s.T(), func() {
+		mock.AndCallsFunc(func(trash1 string) int { return 0 })
+	}, "missing parameter should panic")
+	assert.Panics(s.T(), func() {
+		mock.AndCallsFunc(func(trash1 string, trash2 int) string { return "" })
+	}, "mismatched result type should panic")
+	assert.Panics(s.T(), func() {
+		mock.AndCallsFunc(func(ex *ExampleExternalStruct, trash1 string, trash2 int) int { return 0 })
+	}, "receiver parameter should panic")
+	assert.Panics(s.T(), func() {
+		mock.AndCallsFunc(7)
+	}, "non-func should panic")
+}
+
+func (s *testMockExpectationBuilder) TestAndCallsFuncExclusiveWithAndCallsOriginal() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj).ToReceive("ExamplePublicMethod").AndCallsOriginal()
+	assert.Panics(s.T(), func() {
+		mock.AndCallsFunc(func(trash1 string, trash2 int) int { return 0 })
+	})
+}
+
+func (s *testMockExpectationBuilder) TestWithReturnsInOrderRepeatsLastByDefault() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj)
+	mock.ToReceive("ExamplePublicMethod").WithReturnsInOrder([]interface{}{1}, []interface{}{2})
+	assert.Equal(s.T(), []interface{}{1}, mock.Call("ExamplePublicMethod", "taco", 7))
+	assert.Equal(s.T(), []interface{}{2}, mock.Call("ExamplePublicMethod", "taco", 7))
+	assert.Equal(s.T(), []interface{}{2}, mock.Call("ExamplePublicMethod", "taco", 7))
+}
+
+func (s *testMockExpectationBuilder) TestThenReturnsChainsSequence() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj)
+	mock.ToReceive("ExamplePublicMethod").WithReturns(1).ThenReturns(2).ThenReturns(3).
+		OnReturnsExhausted(monkeymock.FailWhenExhausted)
+	assert.Equal(s.T(), []interface{}{1}, mock.Call("ExamplePublicMethod", "taco", 7))
+	assert.Equal(s.T(), []interface{}{2}, mock.Call("ExamplePublicMethod", "taco", 7))
+	assert.Equal(s.T(), []interface{}{3}, mock.Call("ExamplePublicMethod", "taco", 7))
+	assert.Panics(s.T(), func() {
+		mock.Call("ExamplePublicMethod", "taco", 7)
+	})
+}
+
+func (s *testMockExpectationBuilder) TestExhaustedReturnsCallOriginal() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj)
+	mock.ToReceive("ExamplePublicMethod").WithReturns(1).ThenReturns(2).
+		OnReturnsExhausted(monkeymock.CallOriginalWhenExhausted)
+	assert.Equal(s.T(), []interface{}{1}, mock.Call("ExamplePublicMethod", "taco", 7))
+	assert.Equal(s.T(), []interface{}{2}, mock.Call("ExamplePublicMethod", "taco", 7))
+	assert.Equal(s.T(), []interface{}{7}, mock.Call("ExamplePublicMethod", "taco", 7))
+}
+
+func (s *testMockExpectationBuilder) TestExhaustedReturnsCallOriginalReplacesFunc() {
+	testObj := &ExampleExternalStruct{}
+	funcCalls := 0
+	mock := monkeymock.Expect(testObj)
+	mock.ToReceive("ExamplePublicMethod").WithReturns(1).
+		AndCallsFunc(func(trash1 string, trash2 int) int {
+			funcCalls++
+			return 99
+		}).
+		OnReturnsExhausted(monkeymock.CallOriginalWhenExhausted)
+	assert.Equal(s.T(), []interface{}{1}, mock.Call("ExamplePublicMethod", "taco", 7))
+	assert.Equal(s.T(), []interface{}{7}, mock.Call("ExamplePublicMethod", "taco", 7))
+	assert.Equal(s.T(), 1, funcCalls)
+}
+
+func (s *testMockExpectationBuilder) TestThenReturnsRequiresWithReturns() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj).ToReceive("ExamplePublicMethod")
+	assert.Panics(s.T(), func() {
+		mock.ThenReturns(2)
+	})
+}
+
+func (s *testMockExpectationBuilder) TestAndPanicsPanicsWithValue() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj)
+	mock.ToReceive("ExamplePublicMethod").Once().AndPanics("kaboom").ExpectsPanic()
+	assert.PanicsWithValue(s.T(), "kaboom", func() {
+		mock.Call("ExamplePublicMethod", "taco", 7)
+	})
+	mock.AssertExpectations(s.fakeT)
+	assert.False(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestExpectsPanicFromOriginal() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj)
+	mock.ToReceive("ExamplePublicMethod").AndCallsOriginal().ExpectsPanic()
+	assert.PanicsWithValue(s.T(), "i was told to panic!", func() {
+		mock.Call("ExamplePublicMethod", "panic", 7)
+	})
+	mock.AssertExpectations(s.fakeT)
+	assert.False(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestExpectsPanicFailsWhenCallReturns() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj)
+	mock.ToReceive("ExamplePublicMethod").AndCallsOriginal().ExpectsPanic()
+	mock.Call("ExamplePublicMethod", "taco", 7)
+	mock.AssertExpectations(s.fakeT)
+	assert.True(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestAndPanicsRejectsNil() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj).ToReceive("ExamplePublicMethod")
+	assert.Panics(s.T(), func() {
+		mock.AndPanics(nil)
+	}, "a nil panic would be recorded as a normal return")
+}
+
+func (s *testMockExpectationBuilder) TestAndPanicsExclusiveWithOtherHandlers() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj).ToReceive("ExamplePublicMethod").AndPanics("kaboom")
+	assert.Panics(s.T(), func() {
+		mock.AndCallsOriginal()
+	})
+}
+
+func (s *testMockExpectationBuilder) TestTimesZeroAssertsNoCalls() {
+	testObj := &ExampleExternalStruct{}
+	mock := monkeymock.Expect(testObj)
+	mock.ToReceive("ExamplePublicMethod").Times(0).WithReturns(1)
+	mock.AssertExpectations(s.fakeT)
+	assert.False(s.T(), s.fakeT.Failed())
+	assert.Panics(s.T(), func() {
+		mock.Call("ExamplePublicMethod", "taco", 7)
+	})
+	mock.AssertExpectations(s.fakeT)
+	assert.True(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestFlexibleCallCounts() {
+	callNTimes := func(mock monkeymock.Mock, n int) {
+		for i := 0; i < n; i++ {
+			mock.Call("ExamplePublicMethod", "taco", 7)
+		}
+	}
+	for _, tc := range []struct {
+		name   string
+		setup  func(mock monkeymock.Mock) monkeymock.Mock
+		calls  int
+		failed bool
+	}{
+		{"AtLeast satisfied", func(m monkeymock.Mock) monkeymock.Mock { return m.AtLeast(2) }, 3, false},
+		{"AtLeast unsatisfied", func(m monkeymock.Mock) monkeymock.Mock { return m.AtLeast(2) }, 1, true},
+		{"AtMost satisfied", func(m monkeymock.Mock) monkeymock.Mock { return m.AtMost(2) }, 2, false},
+		{"AtMost unsatisfied", func(m monkeymock.Mock) monkeymock.Mock { return m.AtMost(2) }, 3, true},
+		{"Between satisfied", func(m monkeymock.Mock) monkeymock.Mock { return m.Between(1, 3) }, 3, false},
+		{"Between under", func(m monkeymock.Mock) monkeymock.Mock { return m.Between(1, 3) }, 0, true},
+		{"Between over", func(m monkeymock.Mock) monkeymock.Mock { return m.Between(1, 3) }, 4, true},
+		{"AnyNumberOfTimes", func(m monkeymock.Mock) monkeymock.Mock { return m.AnyNumberOfTimes() }, 5, false},
+	} {
+		fakeT := new(testing.T)
+		mock := tc.setup(monkeymock.Expect(&ExampleExternalStruct{}).ToReceive("ExamplePublicMethod")).WithReturns(1)
+		callNTimes(mock, tc.calls)
+		mock.AssertExpectations(fakeT)
+		assert.Equal(s.T(), tc.failed, fakeT.Failed(), tc.name)
+	}
+}
+
+func (s *testMockExpectationBuilder) TestInvalidCallCountsPanic() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).ToReceive("ExamplePublicMethod")
+	assert.Panics(s.T(), func() { mock.Times(-1) })
+	assert.Panics(s.T(), func() { mock.AtLeast(-1) })
+	assert.Panics(s.T(), func() { mock.Between(3, 1) })
+}
+
+func (s *testMockExpectationBuilder) TestInOrderAcrossMocksPasses() {
+	db, tx := &ExampleExternalStruct{}, &ExampleExternalStruct{}
+	dbMock, txMock := monkeymock.Expect(db), monkeymock.Expect(tx)
+	begin := dbMock.ToReceive("ExampleVoidMethod").WithReturns().Expectation()
+	exec := dbMock.ToReceive("ExamplePublicMethod").WithReturns(1).Expectation()
+	commit := txMock.ToReceive("ExampleOtherMethod").WithReturns("ok").Expectation()
+	monkeymock.InOrder(begin, exec, commit)
+
+	dbMock.Call("ExampleVoidMethod")
+	dbMock.Call("ExamplePublicMethod", "taco", 7)
+	dbMock.Call("ExamplePublicMethod", "taco", 7)
+	txMock.Call("ExampleOtherMethod", "done")
+	dbMock.AssertExpectations(s.fakeT)
+	txMock.AssertExpectations(s.fakeT)
+	assert.False(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestInOrderViolationFailsAssert() {
+	db, tx := &ExampleExternalStruct{}, &ExampleExternalStruct{}
+	dbMock, txMock := monkeymock.Expect(db), monkeymock.Expect(tx)
+	dbMock.ToReceive("ExamplePublicMethod").WithReturns(1)
+	txMock.ToReceive("ExampleOtherMethod").WithReturns("ok")
+	monkeymock.InOrder(dbMock, txMock)
+
+	txMock.Call("ExampleOtherMethod", "done")
+	dbMock.Call("ExamplePublicMethod", "taco", 7)
+	txMock.AssertExpectations(s.fakeT)
+	assert.True(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestAfterDeclaresPartialOrder() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{})
+	first := mock.ToReceive("ExampleVoidMethod").WithReturns().Expectation()
+	mock.ToReceive("ExamplePublicMethod").WithReturns(1).After(first)
+	mock.ToReceive("ExampleOtherMethod").WithReturns("ok").After(first)
+
+	mock.Call("ExampleVoidMethod")
+	mock.Call("ExampleOtherMethod", "done")
+	mock.Call("ExamplePublicMethod", "taco", 7)
+	mock.AssertExpectations(s.fakeT)
+	assert.False(s.T(), s.fakeT.Failed())
+
+	mock.Call("ExampleVoidMethod") // prerequisite called again after its dependents
+	mock.AssertExpectations(s.fakeT)
+	assert.True(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestAfterWithUncalledPrerequisiteFailsAssert() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{})
+	first := mock.ToReceive("ExampleVoidMethod").WithReturns().Expectation()
+	mock.ToReceive("ExamplePublicMethod").WithReturns(1).After(first)
+	mock.Call("ExamplePublicMethod", "taco", 7)
+	mock.AssertExpectations(s.fakeT)
+	assert.True(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestWithReturnsValidatesSignature() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).ToReceive("ExampleErrorMethod")
+	assert.Panics(s.T(), func() { mock.WithReturns(7) }, "too few returns should panic")
+	assert.Panics(s.T(), func() { mock.WithReturns(7, nil, nil) }, "too many returns should panic")
+	assert.Panics(s.T(), func() { mock.WithReturns("7", nil) }, "wrong return type should panic")
+	assert.Panics(s.T(), func() { mock.WithReturns(7, "oops") }, "non-error for error result should panic")
+	assert.Panics(s.T(), func() {
+		mock.WithReturnsInOrder([]interface{}{7, nil}, []interface{}{"7", nil})
+	}, "wrong return type within sequence should panic")
+
+	panicMsg := ""
+	func() {
+		defer func() { panicMsg = fmt.Sprint(recover()) }()
+		mock.WithReturns("7")
+	}()
+	assert.Contains(s.T(), panicMsg, "<*ExampleExternalStruct>.ExampleErrorMethod")
+	assert.Contains(s.T(), panicMsg, "<int>, <error>")
+	assert.Contains(s.T(), panicMsg, "<string>")
+}
+
+func (s *testMockExpectationBuilder) TestWithReturnsAcceptsNilAndInterfaceValues() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).ToReceive("ExampleErrorMethod")
+	assert.NotPanics(s.T(), func() {
+		mock.WithReturns(7, nil).ThenReturns(0, fmt.Errorf("oops"))
+	})
+	assert.Equal(s.T(), []interface{}{7, nil}, mock.Call("ExampleErrorMethod", "taco"))
+}
+
+func (s *testMockExpectationBuilder) TestVoidMethodNeedsNoReturns() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{})
+	mock.ToReceive("ExampleVoidMethod").Once()
+	assert.NotPanics(s.T(), func() {
+		mock.Call("ExampleVoidMethod")
+	})
+	mock.AssertExpectations(s.fakeT)
+	assert.False(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestOriginalReturnsMismatchFailsAssert() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{})
+	mock.ToReceive("ExamplePublicMethod").WithReturns(19).AndCallsOriginal()
+	assert.Equal(s.T(), []interface{}{19}, mock.Call("ExamplePublicMethod", "taco", 7))
+	mock.AssertExpectations(s.fakeT)
+	assert.True(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestOriginalReturnsMatchPassesAssert() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{})
+	mock.ToReceive("ExamplePublicMethod").WithReturns(7).AndCallsOriginal()
+	mock.Call("ExamplePublicMethod", "taco", 7)
+	mock.AssertExpectations(s.fakeT)
+	assert.False(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestReturnMatchersVerifyOriginalLoosely() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{})
+	mock.ToReceive("ExampleErrorMethod").
+		WithReturns(monkeymock.Satisfies(func(v int) bool { return v > 3 }), nil).
+		AndCallsOriginal()
+	assert.Equal(s.T(), []interface{}{7, nil}, mock.Call("ExampleErrorMethod", "burrito"),
+		"real values pass through where matchers were declared")
+	mock.AssertExpectations(s.fakeT)
+	assert.False(s.T(), s.fakeT.Failed())
+
+	mock.Call("ExampleErrorMethod", "ok")
+	mock.AssertExpectations(s.fakeT)
+	assert.True(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestReturnMatchersWithoutHandlerPanic() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{})
+	mock.ToReceive("ExamplePublicMethod").WithReturns(monkeymock.Any())
+	assert.Panics(s.T(), func() {
+		mock.Call("ExamplePublicMethod", "taco", 7)
+	})
+}
+
+func (s *testMockExpectationBuilder) TestUnexpectedCallFailsAssert() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{})
+	mock.ToReceive("ExamplePublicMethod").WithArgs("taco", 7).Maybe().WithReturns(2)
+	mock.AssertExpectations(s.fakeT)
+	require.False(s.T(), s.fakeT.Failed())
+	assert.Panics(s.T(), func() {
+		mock.Call("ExamplePublicMethod", "burrito", 7)
+	})
+	mock.AssertExpectations(s.fakeT)
+	assert.True(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestVariadicFlattenedArgs() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).
+		ToReceive("ExampleVariadicMethod").WithArgs("%s=%d", "a", 1).WithReturns("matched").
+		ToReceive("ExampleVariadicMethod").WithAnyArgs().WithReturns("default")
+	assert.Equal(s.T(), []interface{}{"matched"}, mock.Call("ExampleVariadicMethod", "%s=%d", "a", 1))
+	assert.Equal(s.T(), []interface{}{"matched"}, mock.Call("ExampleVariadicMethod", "%s=%d", []interface{}{"a", 1}))
+	assert.Equal(s.T(), []interface{}{"default"}, mock.Call("ExampleVariadicMethod", "%s=%d", "a", 2))
+	assert.Equal(s.T(), []interface{}{"default"}, mock.Call("ExampleVariadicMethod", "%s=%d", "a"))
+}
+
+func (s *testMockExpectationBuilder) TestVariadicSliceArgs() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).
+		ToReceive("ExampleSumMethod").WithArgs([]int{1, 2}).WithReturns(100).
+		ToReceive("ExampleSumMethod").WithArgs().WithReturns(-1)
+	assert.Equal(s.T(), []interface{}{100}, mock.Call("ExampleSumMethod", 1, 2))
+	assert.Equal(s.T(), []interface{}{100}, mock.Call("ExampleSumMethod", []int{1, 2}))
+	assert.Equal(s.T(), []interface{}{-1}, mock.Call("ExampleSumMethod"))
+	assert.Equal(s.T(), []interface{}{-1}, mock.Call("ExampleSumMethod", []int(nil)))
+	assert.Panics(s.T(), func() {
+		mock.Call("ExampleSumMethod", 1, 2, 3)
+	}, "no expectation for these args")
+}
+
+func (s *testMockExpectationBuilder) TestVariadicArgMatchers() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).
+		ToReceive("ExampleVariadicMethod").WithArgs(monkeymock.HasPrefix("user:"), monkeymock.Any(), 7).WithReturns("matched")
+	assert.Equal(s.T(), []interface{}{"matched"}, mock.Call("ExampleVariadicMethod", "user:%s %d", "bob", 7))
+	assert.Panics(s.T(), func() {
+		mock.Call("ExampleVariadicMethod", "user:%s %d", "bob", 8)
+	})
+}
+
+func (s *testMockExpectationBuilder) TestVariadicWithArgsTypeChecked() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).ToReceive("ExampleSumMethod")
+	assert.Panics(s.T(), func() {
+		mock.WithArgs(1, "two")
+	}, "variadic elements should be type checked")
+	assert.NotPanics(s.T(), func() {
+		monkeymock.Expect(&ExampleExternalStruct{}).ToReceive("ExampleSumMethod").WithArgs(1, monkeymock.Any())
+	})
+	assert.Panics(s.T(), func() {
+		monkeymock.Expect(&ExampleExternalStruct{}).ToReceive("ExampleVariadicMethod").WithArgs()
+	}, "fixed args are still required")
+}
+
+func (s *testMockExpectationBuilder) TestVariadicAndCallsOriginal() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).ToReceive("ExampleVariadicMethod").AndCallsOriginal()
+	assert.Equal(s.T(), []interface{}{"a=1"}, mock.Call("ExampleVariadicMethod", "%s=%d", "a", 1))
+	assert.Equal(s.T(), []interface{}{"none"}, mock.Call("ExampleVariadicMethod", "none"))
+	mock.AssertExpectations(s.fakeT)
+	assert.False(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestVariadicAndCallsFunc() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).ToReceive("ExampleSumMethod").
+		AndCallsFunc(func(values ...int) int { return len(values) })
+	assert.Equal(s.T(), []interface{}{3}, mock.Call("ExampleSumMethod", 4, 5, 6))
+	assert.Panics(s.T(), func() {
+		monkeymock.Expect(&ExampleExternalStruct{}).ToReceive("ExampleSumMethod").
+			AndCallsFunc(func(values []int) int { return len(values) })
+	}, "func must also be variadic")
+}
+
+func (s *testMockExpectationBuilder) TestNilArgsAndReturns() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).
+		ToReceive("ExampleLookupMethod").WithArgs(nil, nil).WithReturns(nil)
+	assert.Equal(s.T(), []interface{}{(*ExampleExternalStruct)(nil)}, mock.Call("ExampleLookupMethod", nil, nil))
+	assert.Equal(s.T(), []interface{}{(*ExampleExternalStruct)(nil)},
+		mock.Call("ExampleLookupMethod", (*ExampleExternalStruct)(nil), map[string]int(nil)))
+	assert.Panics(s.T(), func() {
+		monkeymock.Expect(&ExampleExternalStruct{}).ToReceive("ExamplePublicMethod").WithArgs("junk", nil)
+	}, "nil is not valid for an int")
+}
+
+func (s *testMockExpectationBuilder) TestAssignableArgs() {
+	buffer := new(bytes.Buffer)
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).
+		ToReceive("ExampleWriterMethod").WithArgs(buffer, 7).WithReturns(nil).
+		ToReceive("ExampleWriterMethod").WithAnyArgs().WithReturns(io.EOF)
+	assert.Equal(s.T(), []interface{}{nil}, mock.Call("ExampleWriterMethod", buffer, int64(7)))
+	assert.Equal(s.T(), []interface{}{nil}, mock.Call("ExampleWriterMethod", buffer, 7), "untyped constants are converted")
+	assert.Equal(s.T(), []interface{}{io.EOF}, mock.Call("ExampleWriterMethod", bytes.NewBufferString("other"), 7))
+	assert.Panics(s.T(), func() {
+		monkeymock.Expect(&ExampleExternalStruct{}).ToReceive("ExampleWriterMethod").WithArgs("not a writer", 7)
+	})
+	assert.Panics(s.T(), func() {
+		monkeymock.Expect(&ExampleExternalStruct{}).ToReceive("ExampleWriterMethod").WithArgs(buffer, 7.5)
+	}, "lossy conversions are refused")
+}
+
+func (s *testMockExpectationBuilder) TestCallHistory() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).
+		ToReceive("ExamplePublicMethod").WithArgs("junk", 7).WithReturns(70).
+		ToReceive("ExamplePublicMethod").WithAnyArgs().AndCallsOriginal()
+	assert.Equal(s.T(), 0, mock.CallCount("ExamplePublicMethod"))
+	_, found := mock.LastCall("ExamplePublicMethod")
+	assert.False(s.T(), found)
+
+	before := time.Now()
+	mock.Call("ExamplePublicMethod", "junk", 7)
+	mock.Call("ExamplePublicMethod", "other", 8)
+	assert.Panics(s.T(), func() { mock.Call("ExamplePublicMethod", "panic", 9) })
+
+	calls := mock.Calls("ExamplePublicMethod")
+	if assert.Len(s.T(), calls, 3) {
+		assert.Equal(s.T(), []interface{}{"junk", 7}, calls[0].Args)
+		assert.Equal(s.T(), []interface{}{70}, calls[0].Returns)
+		assert.Equal(s.T(), []interface{}{8}, calls[1].Returns)
+		assert.Nil(s.T(), calls[1].Panic)
+		assert.Equal(s.T(), "i was told to panic!", calls[2].Panic)
+		assert.Nil(s.T(), calls[2].Returns)
+		assert.True(s.T(), calls[0].Sequence < calls[1].Sequence && calls[1].Sequence < calls[2].Sequence)
+		assert.False(s.T(), calls[0].Timestamp.Before(before))
+		assert.Equal(s.T(), calls[0].GoroutineID, calls[2].GoroutineID)
+		assert.True(s.T(), calls[1].Duration >= 0)
+	}
+	last, found := mock.LastCall("ExamplePublicMethod")
+	assert.True(s.T(), found)
+	assert.Equal(s.T(), calls[2], last)
+	assert.Equal(s.T(), 3, mock.CallCount("ExamplePublicMethod"))
+	assert.Equal(s.T(), 0, mock.CallCount("ExampleOtherMethod"), "known methods without calls are empty")
+	assert.Panics(s.T(), func() { mock.Calls("NoSuchMethod") })
+}
+
+func (s *testMockExpectationBuilder) TestCallHistoryFromOtherGoroutine() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).ToReceive("ExampleSumMethod").AndCallsOriginal()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		mock.Call("ExampleSumMethod", 1, 2)
+	}()
+	<-done
+	mock.Call("ExampleSumMethod")
+	calls := mock.Calls("ExampleSumMethod")
+	if assert.Len(s.T(), calls, 2) {
+		assert.Equal(s.T(), []interface{}{[]int{1, 2}}, calls[0].Args, "variadic args are recorded as a slice")
+		assert.NotEqual(s.T(), calls[0].GoroutineID, calls[1].GoroutineID)
+	}
+}
+
+func (s *testMockExpectationBuilder) TestCaptor() {
+	captor := monkeymock.NewCaptor()
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).
+		ToReceive("ExamplePublicMethod").WithArgs(captor, 7).WithReturns(70).
+		ToReceive("ExamplePublicMethod").WithAnyArgs().WithReturns(0)
+	assert.Panics(s.T(), func() { captor.Value() }, "nothing captured yet")
+
+	mock.Call("ExamplePublicMethod", "first", 7)
+	mock.Call("ExamplePublicMethod", "ignored", 8)
+	mock.Call("ExamplePublicMethod", "second", 7)
+	assert.Equal(s.T(), "second", captor.Value())
+	assert.Equal(s.T(), []interface{}{"first", "second"}, captor.Values(), "only calls dispatched to the expectation are captured")
+	assert.Equal(s.T(), "second", monkeymock.CapturedValue[string](captor))
+	assert.Equal(s.T(), []string{"first", "second"}, monkeymock.CapturedValues[string](captor))
+	assert.Panics(s.T(), func() { monkeymock.CapturedValue[int](captor) })
+}
+
+func (s *testMockExpectationBuilder) TestCaptorTypedArgs() {
+	writer, tags := monkeymock.NewCaptor(), monkeymock.NewCaptor()
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).
+		ToReceive("ExampleWriterMethod").WithArgs(writer, monkeymock.Any()).WithReturns(nil).
+		ToReceive("ExampleLookupMethod").WithArgs(monkeymock.Any(), tags).WithReturns(nil)
+	buffer := new(bytes.Buffer)
+	mock.Call("ExampleWriterMethod", buffer, 7)
+	mock.Call("ExampleWriterMethod", nil, 7)
+	mock.Call("ExampleLookupMethod", nil, map[string]int{"a": 1})
+	assert.Equal(s.T(), []io.Writer{buffer, nil}, monkeymock.CapturedValues[io.Writer](writer))
+	assert.Equal(s.T(), map[string]int{"a": 1}, monkeymock.CapturedValue[map[string]int](tags))
+	assert.Panics(s.T(), func() {
+		monkeymock.Expect(&ExampleExternalStruct{}).ToReceive("ExampleWriterMethod").WithArgs(monkeymock.Any(), writer)
+	}, "captor already bound to io.Writer")
+}
+
+func (s *testMockExpectationBuilder) TestCaptorVariadicArgs() {
+	captor := monkeymock.NewCaptor()
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).
+		ToReceive("ExampleSumMethod").WithArgs(1, captor).WithReturns(0)
+	mock.Call("ExampleSumMethod", 1, 2)
+	mock.Call("ExampleSumMethod", []int{1, 3})
+	assert.Equal(s.T(), []int{2, 3}, monkeymock.CapturedValues[int](captor))
+}
+
+func (s *testMockExpectationBuilder) TestAndSetsArg() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).
+		ToReceive("ExampleFillMethod").WithAnyArgs().WithReturns(3).
+		AndSetsArg(1, 7).AndSetsArg(2, []byte("abc")).AndSetsArg(3, map[string]int{"a": 1})
+	out, buf, tags := 0, make([]byte, 2), map[string]int{"b": 2}
+	assert.Equal(s.T(), []interface{}{3}, mock.Call("ExampleFillMethod", "key", &out, buf, tags))
+	assert.Equal(s.T(), 7, out)
+	assert.Equal(s.T(), []byte("ab"), buf, "slice elements are copied, up to the length of the arg")
+	assert.Equal(s.T(), map[string]int{"a": 1, "b": 2}, tags)
+	assert.Panics(s.T(), func() {
+		mock.Call("ExampleFillMethod", "key", nil, buf, tags)
+	}, "nil pointers cannot be written through")
+}
+
+func (s *testMockExpectationBuilder) TestAndSetsArgInterfaceParams() {
+	type decoded struct{ Name string }
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).
+		ToReceive("ExampleDecodeMethod").WithAnyArgs().WithReturns(nil).AndSetsArg(0, decoded{Name: "alice"}).
+		ToReceive("ExampleScanMethod").WithAnyArgs().WithReturns(nil).AndSetsArg(0, 7).AndSetsArg(1, "seven")
+	var target decoded
+	assert.Equal(s.T(), []interface{}{nil}, mock.Call("ExampleDecodeMethod", &target))
+	assert.Equal(s.T(), "alice", target.Name)
+	assert.Panics(s.T(), func() {
+		mock.Call("ExampleDecodeMethod", new(int))
+	}, "interface params are checked at call time")
+
+	var number int
+	var name string
+	mock.Call("ExampleScanMethod", &number, &name)
+	assert.Equal(s.T(), 7, number)
+	assert.Equal(s.T(), "seven", name)
+	assert.Panics(s.T(), func() {
+		mock.Call("ExampleScanMethod", &number)
+	}, "variadic element to write through is missing")
+}
+
+func (s *testMockExpectationBuilder) TestAndSetsArgFunc() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).
+		ToReceive("ExampleFillMethod").WithAnyArgs().WithReturns(1).
+		AndSetsArgFunc(1, func(out *int) { *out += 10 }).
+		ToReceive("ExampleDecodeMethod").WithAnyArgs().WithReturns(nil).
+		AndSetsArgFunc(0, func(out *string) { *out = "decoded" })
+	out := 5
+	mock.Call("ExampleFillMethod", "key", &out, []byte{}, map[string]int{})
+	assert.Equal(s.T(), 15, out)
+	var decoded string
+	mock.Call("ExampleDecodeMethod", &decoded)
+	assert.Equal(s.T(), "decoded", decoded)
+	assert.Panics(s.T(), func() {
+		mock.Call("ExampleDecodeMethod", new(int))
+	})
+}
+
+func (s *testMockExpectationBuilder) TestAndSetsArgFuncPanicsAreRecorded() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).
+		ToReceive("ExampleDecodeMethod").WithAnyArgs().WithReturns(nil).
+		AndSetsArgFunc(0, func(out *string) { panic("fill failed") }).ExpectsPanic()
+	var decoded string
+	assert.PanicsWithValue(s.T(), "fill failed", func() {
+		mock.Call("ExampleDecodeMethod", &decoded)
+	})
+	lastCall, _ := mock.LastCall("ExampleDecodeMethod")
+	assert.Equal(s.T(), "fill failed", lastCall.Panic)
+	mock.AssertExpectations(s.fakeT)
+	assert.False(s.T(), s.fakeT.Failed())
+}
+
+func (s *testMockExpectationBuilder) TestAndSetsArgTypeChecked() {
+	mock := monkeymock.Expect(&ExampleExternalStruct{}).ToReceive("ExampleFillMethod")
+	assert.Panics(s.T(), func() { mock.AndSetsArg(0, "key") }, "string params cannot be written through")
+	assert.Panics(s.T(), func() { mock.AndSetsArg(1, "seven") }, "value must suit the pointer")
+	assert.Panics(s.T(), func() { mock.AndSetsArg(2, "abc") }, "value must suit the slice")
+	assert.Panics(s.T(), func() { mock.AndSetsArg(4, 7) }, "no such arg")
+	assert.Panics(s.T(), func() { mock.AndSetsArgFunc(1, func(out *string) {}) })
+	assert.Panics(s.T(), func() { mock.AndSetsArgFunc(1, func(out *int) error { return nil }) })
+	assert.NotPanics(s.T(), func() {
+		mock.AndSetsArg(1, int8(7)).AndSetsArgFunc(3, func(tags map[string]int) {})
+	})
+}
+
 // Mock Doubles cannot work until named exported methods can be injected into runtime defined structs
 func TestMockDoubles(t *testing.T) {
 	// suite.Run(t, new(testMockDoubles))
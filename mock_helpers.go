@@ -14,6 +14,9 @@ func (m *mockStruct) gRefObjectTypeName() string {
 // supports custom object types (custom struct and interface names)
 func getHumanTypeName(object interface{}) string {
 	t := reflect.TypeOf(object)
+	if t == nil {
+		return "nil"
+	}
 	var tname string
 	if t.Kind() == reflect.Ptr {
 		tname = t.Elem().Name()
@@ -28,8 +28,8 @@ func (s *testInternalMocks) TestMockListManipilation() {
 		require.Equal(s.T(), 3, sizeOfMockList())
 		removeFromMockList(first)
 		require.Equal(s.T(), 2, sizeOfMockList())
-		AssertSame(s.T(), gTheMockList[0], second)
-		AssertSame(s.T(), gTheMockList[1], third)
+		AssertSame(s.T(), gDefaultController.mockList[0], second)
+		AssertSame(s.T(), gDefaultController.mockList[1], third)
 		clearMockList()
 	}
 
@@ -42,8 +42,8 @@ func (s *testInternalMocks) TestMockListManipilation() {
 		require.Equal(s.T(), 3, sizeOfMockList())
 		removeFromMockList(third)
 		require.Equal(s.T(), 2, sizeOfMockList())
-		AssertSame(s.T(), gTheMockList[0], first)
-		AssertSame(s.T(), gTheMockList[1], second)
+		AssertSame(s.T(), gDefaultController.mockList[0], first)
+		AssertSame(s.T(), gDefaultController.mockList[1], second)
 		clearMockList()
 	}
 	// can remove the middle mock
@@ -55,8 +55,8 @@ func (s *testInternalMocks) TestMockListManipilation() {
 		require.Equal(s.T(), 3, sizeOfMockList())
 		removeFromMockList(second)
 		require.Equal(s.T(), 2, sizeOfMockList())
-		AssertSame(s.T(), gTheMockList[0], first)
-		AssertSame(s.T(), gTheMockList[1], third)
+		AssertSame(s.T(), gDefaultController.mockList[0], first)
+		AssertSame(s.T(), gDefaultController.mockList[1], third)
 		clearMockList()
 	}
 }
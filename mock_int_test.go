@@ -52,6 +52,7 @@ type someExampleStruct struct {
 
 func (s *someExampleStruct) ExampleMethod(trash1 string, trash2 int) int { return 8 }
 func (s *someExampleStruct) ExampleMethod2()                             {}
+func (s *someExampleStruct) ExamplePanicMethod()                         { panic("original panic") }
 
 func (s *testInternalMocks) TestGetMethodByName() {
 	{
@@ -85,6 +86,45 @@ func (s *testInternalMocks) TestVoidReturnsOnCalls() {
 	}
 }
 
+func (s *testInternalMocks) TestCallRecordsPanics() {
+	testObj := &someExampleStruct{}
+	mock := Expect(testObj).ToReceive("ExamplePanicMethod").AndCallsOriginal().(*mockStruct)
+	require.Panics(s.T(), func() {
+		mock.Call("ExamplePanicMethod")
+	})
+	callRecords := mock.lastmockMethodStructPtr.callRecords
+	require.Len(s.T(), callRecords, 1)
+	assert.True(s.T(), callRecords[0].panicked)
+	assert.Equal(s.T(), "original panic", callRecords[0].panicValue)
+	clearMockList()
+}
+
+func (s *testInternalMocks) TestUnexpectedCallsAreRecorded() {
+	mock := Expect(&someExampleStruct{}).(*mockStruct)
+	mock.ToReceive("ExampleMethod").WithArgs("junk", 1).WithReturns(1)
+	closest := mock.ToReceive("ExampleMethod").WithArgs("junk", 2).WithReturns(2).(*mockStruct).lastmockMethodStructPtr
+	mock.ToReceive("ExampleMethod").WithArgs("trash", 3).WithReturns(3)
+	require.Panics(s.T(), func() {
+		mock.Call("ExampleMethod", "trash", 2)
+	})
+	require.Len(s.T(), mock.unexpectedCallRecords, 1)
+	assert.Equal(s.T(), methodArgumentsList{"trash", 2}, mock.unexpectedCallRecords[0].givenArgs)
+	assert.Equal(s.T(), "ExampleMethod", mock.unexpectedCallRecords[0].methodName)
+	AssertSame(s.T(), closest, mock.unexpectedCallRecords[0].closest)
+	clearMockList()
+}
+
+func (s *testInternalMocks) TestCallCardinalityInWords() {
+	assert.Equal(s.T(), "never", exactCalls(0).String())
+	assert.Equal(s.T(), "exactly once", exactCalls(1).String())
+	assert.Equal(s.T(), "exactly twice", exactCalls(2).String())
+	assert.Equal(s.T(), "exactly 3 times", exactCalls(3).String())
+	assert.Equal(s.T(), "any number of times", anyNumberOfCalls().String())
+	assert.Equal(s.T(), "at least once", atLeastCalls(1).String())
+	assert.Equal(s.T(), "at most 4 times", atMostCalls(4).String())
+	assert.Equal(s.T(), "between 2 and 4 times", betweenCalls(2, 4).String())
+}
+
 func (s *testInternalMocks) TestGetNormalizedTypes() {
 	type junkType struct {
 		i int
@@ -156,3 +196,90 @@ func (s *testInternalMocks) TestGetNormalizedTypes() {
 		assert.Equal(s.T(), reflect.Int, outObjType.Kind())
 	}
 }
+
+func (s *testInternalMocks) TestPackageAssertExpectationsResetsByDefault() {
+	clearMockList()
+	Expect(&someExampleStruct{}).ToReceive("ExampleMethod2").Once()
+	Expect(&someExampleStruct{}).ToReceive("ExampleMethod2").Never()
+	require.Equal(s.T(), 2, sizeOfMockList())
+
+	fakeT := new(testing.T)
+	AssertExpectations(fakeT)
+	assert.True(s.T(), fakeT.Failed(), "unmet expectation should fail")
+	assert.Equal(s.T(), 0, sizeOfMockList(), "mocks should be cleared after verification")
+
+	fakeT = new(testing.T)
+	AssertExpectations(fakeT)
+	assert.False(s.T(), fakeT.Failed(), "nothing left to verify")
+}
+
+func (s *testInternalMocks) TestPackageAssertExpectationsKeepExpectations() {
+	clearMockList()
+	Expect(&someExampleStruct{}).ToReceive("ExampleMethod2").Once()
+
+	fakeT := new(testing.T)
+	AssertExpectations(fakeT, KeepExpectations())
+	assert.True(s.T(), fakeT.Failed())
+	assert.Equal(s.T(), 1, sizeOfMockList(), "mocks should be kept")
+
+	fakeT = new(testing.T)
+	AssertExpectations(fakeT)
+	assert.True(s.T(), fakeT.Failed(), "kept expectations are verified again")
+	assert.Equal(s.T(), 0, sizeOfMockList())
+}
+
+func (s *testInternalMocks) TestMockFailuresAreGrouped() {
+	report := stringifyMockFailures("Mock #2 of 3 <*Thing>", assertFailures{"first: \nmethod  : A\n", "second\n"})
+	assert.Equal(s.T(), "Mock #2 of 3 <*Thing>: 2 failures\n"+
+		"    first: \n"+
+		"    method  : A\n"+
+		"    second\n", report)
+	assert.Contains(s.T(), stringifyMockFailures("Mock <*Thing>", assertFailures{"only"}), ": 1 failure\n")
+}
+
+func (s *testInternalMocks) TestAssertExpectationsFailFast() {
+	clearMockList()
+	Expect(&someExampleStruct{}).ToReceive("ExampleMethod2").Once()
+	Expect(&someExampleStruct{}).ToReceive("ExampleMethod2").Twice()
+
+	fakeT := &FakeT{}
+	AssertExpectations(fakeT, FailFast())
+	assert.Empty(s.T(), fakeT.Errors)
+	require.Len(s.T(), fakeT.Fatals, 1)
+	assert.Contains(s.T(), fakeT.Fatals[0], "Mock #1 of 2")
+	assert.NotContains(s.T(), fakeT.Fatals[0], "Mock #2 of 2", "should stop at the first failing Mock")
+	assert.Zero(s.T(), sizeOfMockList())
+}
+
+func (s *testInternalMocks) TestAssertExpectationsVerbose() {
+	clearMockList()
+	testObj := &someExampleStruct{}
+	mock := Expect(testObj).ToReceive("ExampleMethod").WithAnyArgs().WithReturns(3)
+	mock.Call("ExampleMethod", "taco", 1)
+
+	fakeT := &FakeT{}
+	mock.AssertExpectations(fakeT, Verbose())
+	assert.False(s.T(), fakeT.Failed())
+	require.Len(s.T(), fakeT.Logs, 1)
+	assert.Contains(s.T(), fakeT.Logs[0], "ExampleMethod")
+	assert.Contains(s.T(), fakeT.Logs[0], `"taco" <string>`)
+	assert.Contains(s.T(), fakeT.Logs[0], "returned: 3 <int>")
+
+	fakeT = &FakeT{}
+	AssertExpectations(fakeT)
+	assert.Empty(s.T(), fakeT.Logs, "nothing is logged unless Verbose")
+}
+
+func (s *testInternalMocks) TestAssertExpectationsOnlyMocks() {
+	clearMockList()
+	selected := Expect(&someExampleStruct{}).ToReceive("ExampleMethod2").Once()
+	other := Expect(&someExampleStruct{}).ToReceive("ExampleMethod2").Once()
+
+	fakeT := &FakeT{}
+	AssertExpectations(fakeT, OnlyMocks(selected))
+	require.Len(s.T(), fakeT.Errors, 1)
+	assert.Contains(s.T(), fakeT.Errors[0], "Mock #1 of 1")
+	require.Equal(s.T(), 1, sizeOfMockList(), "only the selected Mock should be cleared")
+	AssertSame(s.T(), other, gDefaultController.mockList[0])
+	clearMockList()
+}
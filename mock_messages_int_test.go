@@ -0,0 +1,72 @@
+package monkeymock
+
+// Tests that we can only run from an internal perspective
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type testInternalMessages struct {
+	suite.Suite
+}
+
+func TestInternalMessages(t *testing.T) {
+	suite.Run(t, new(testInternalMessages))
+}
+
+type renderInnerStruct struct {
+	N *int
+}
+type renderOuterStruct struct {
+	Name  string
+	inner *renderInnerStruct
+	M     map[string]int
+	Err   error
+}
+
+func (s *testInternalMessages) TestStringifyValue() {
+	n := 7
+	assert.Equal(s.T(), "nil", stringifyValue(nil))
+	assert.Equal(s.T(), `"taco" <string>`, stringifyValue("taco"))
+	assert.Equal(s.T(), "7 <int>", stringifyValue(7))
+	assert.Equal(s.T(), "&7 <*int>", stringifyValue(&n))
+	assert.Equal(s.T(), `error("boom") <*errors.errorString>`, stringifyValue(errors.New("boom")))
+	assert.Equal(s.T(), "[]int(nil) <[]int>", stringifyValue([]int(nil)))
+	assert.Equal(s.T(), `HasPrefix("a")`, stringifyValue(HasPrefix("a")))
+	assert.Equal(s.T(),
+		`&monkeymock.renderOuterStruct{Name: "x", inner: &monkeymock.renderInnerStruct{N: &7}, `+
+			`M: map[string]int{"a": 1, "b": 2}, Err: error("oops")} <*monkeymock.renderOuterStruct>`,
+		stringifyValue(&renderOuterStruct{"x", &renderInnerStruct{&n}, map[string]int{"b": 2, "a": 1}, errors.New("oops")}))
+}
+
+func (s *testInternalMessages) TestStringifyValueHandlesCycles() {
+	type node struct {
+		Next *node
+	}
+	cyclic := &node{}
+	cyclic.Next = cyclic
+	assert.Contains(s.T(), stringifyValue(cyclic), "<cycle ")
+}
+
+func (s *testInternalMessages) TestStringifyMethodExpectationsAndCalls() {
+	mock := Expect(&someExampleStruct{})
+	mockMethod := mock.ToReceive("ExampleMethod").WithArgs("junk", Any()).
+		WithReturns(1).ThenReturns(2).(*mockStruct).lastmockMethodStructPtr
+	assert.Equal(s.T(), `"junk" <string>, Any()`, stringifyMethodArgs(mockMethod))
+	assert.Equal(s.T(), "(1 <int>) then (2 <int>)", stringifyMethodReturns(mockMethod))
+	assert.Equal(s.T(), "          (no calls)\n", stringifyMethodCalls(mockMethod))
+
+	mock.Call("ExampleMethod", "junk", 5)
+	assert.Equal(s.T(), ""+
+		"          1: args: \"junk\" <string>, 5 <int>\n"+
+		"             returned: 1 <int>\n", stringifyMethodCalls(mockMethod))
+
+	anyArgs := mock.ToReceive("ExampleMethod2").(*mockStruct).lastmockMethodStructPtr
+	assert.Equal(s.T(), "(any args)", stringifyMethodArgs(anyArgs))
+	assert.Equal(s.T(), "(none declared)", stringifyMethodReturns(anyArgs))
+	clearMockList()
+}
@@ -2,6 +2,7 @@ package monkeymock
 
 import (
 	"fmt"
+	"reflect"
 )
 
 func panicRuntimeGeneral(msg string) {
@@ -28,6 +29,14 @@ func panicExpectationDeclaredBeforeToReceive(srcMethod string) {
 	tPanicMockSetup(panicMsg)
 }
 
+func panicInvalidCallCount(srcMethod string, count int) {
+	panicMsg := fmt.Sprintf("\n"+
+		"mock.%s called with an invalid call count: %d\n"+
+		"Call counts must be zero or more, and a maximum may not be less than its minimum\n",
+		srcMethod, count)
+	tPanicMockSetup(panicMsg)
+}
+
 func panicArgsAlreadyDeclared(srcMethod string) {
 	panicMsg := fmt.Sprintf("\n"+
 		"Cannot call mock.%s within a mock.ToReceive() with already declared arguments.\n",
@@ -42,6 +51,21 @@ func panicReturnsAlreadyDeclared(srcMethod string) {
 	tPanicMockSetup(panicMsg)
 }
 
+func panicReturnsSequenceEmpty(srcMethod string) {
+	panicMsg := fmt.Sprintf("\n"+
+		"mock.%s requires at least one set of return values.\n",
+		srcMethod)
+	tPanicMockSetup(panicMsg)
+}
+
+func panicReturnsNotYetDeclared(srcMethod string) {
+	panicMsg := fmt.Sprintf("\n"+
+		"mock.%s called before mock.WithReturns()\n"+
+		"WithReturns (or WithReturnsInOrder) must be declared first\n",
+		srcMethod)
+	tPanicMockSetup(panicMsg)
+}
+
 func panicUnmockableType(typeName string) {
 	panicMsg := fmt.Sprintf("\n"+
 		"Expect attempted on an unmockable object type: \n"+
@@ -50,6 +74,15 @@ func panicUnmockableType(typeName string) {
 	tPanicMockSetup(panicMsg)
 }
 
+func panicUnspyableType(typeName string) {
+	panicMsg := fmt.Sprintf("\n"+
+		"Spy attempted on an unspyable object type: \n"+
+		"found type: <%s>\n"+
+		"Spy requires a pointer to a struct\n",
+		typeName)
+	tPanicMockSetup(panicMsg)
+}
+
 func panicMethodNotFoundInObjectRef(typeName string, methodName string) {
 	panicMsg := fmt.Sprintf("\n"+
 		"Cannot create expectation: ToReceive(\"%s\")\n"+
@@ -65,6 +98,37 @@ func panicMockMethodNotFound(typeName string, methodName string) {
 	tPanicMockRuntime(panicMsg)
 }
 
+func panicObjectNotSpied(typeName string, methodName string) {
+	panicMsg := fmt.Sprintf("\n"+
+		"Cannot verify calls to <%s>.%s\n"+
+		"The object is neither a Spy nor the target of any Mock\n",
+		typeName, methodName)
+	tPanicMockRuntime(panicMsg)
+}
+
+func panicMethodNotRecorded(typeName string, methodName string) {
+	panicMsg := fmt.Sprintf("\n"+
+		"Cannot verify calls to <%s>.%s\n"+
+		"Calls to this method are not recorded; a Spy only records methods with pointer receivers,\n"+
+		"and a Mock only those declared via ToReceive\n",
+		typeName, methodName)
+	tPanicMockRuntime(panicMsg)
+}
+
+func panicMockMethodNoMatchingArgs(candidates [](*mockMethodStruct), args methodArgumentsList) {
+	methodName := stringifyMethodName(candidates[0])
+	declared := ""
+	for _, v := range candidates {
+		declared += "          withargs   : " + stringifyMethodArgs(v) + "\n"
+	}
+	tPanicMockRuntime(fmt.Sprintf("Method called with arguments that match no expectation: \n"+
+		"method  : %s\n"+
+		"args received  : %s\n"+
+		"declared expectations: \n"+
+		"%s",
+		methodName, stringifyValuesList(args), declared))
+}
+
 func panicMockMethodReturnsNotDefined(fullMethodName string) {
 	panicMsg := fmt.Sprintf("\n"+
 		"Method called without return value declaration within Mock: %s\n"+
@@ -74,6 +138,21 @@ func panicMockMethodReturnsNotDefined(fullMethodName string) {
 	tPanicMockRuntime(panicMsg)
 }
 
+func panicMockMethodReturnsExhausted(m *mockMethodStruct, callNumber int) {
+	tPanicMockRuntime(fmt.Sprintf("Method called more times than it has declared return values: \n"+
+		"method  : %s\n"+
+		"call #  : %d\n"+
+		"declared return sets: %d\n",
+		stringifyMethodName(m), callNumber, len(m.expectedReturnsSequence)))
+}
+
+func panicMockReturnsMatcherWithoutHandler(m *mockMethodStruct) {
+	tPanicMockRuntime(fmt.Sprintf("Method called with return matchers but nothing to produce the real return values: \n"+
+		"method  : %s\n"+
+		"Matchers within WithReturns can only verify the returns of AndCallsOriginal or AndCallsFunc\n",
+		stringifyMethodName(m)))
+}
+
 func panicMockMethodCallInvalidArgsSignature(mockMethod *mockMethodStruct, expectedSig string, receivedSig string) {
 	methodName := stringifyMethodName(mockMethod)
 	tPanicMockRuntime(fmt.Sprintf("Mock Method called with invalid arguments signature: \n"+
@@ -105,6 +184,116 @@ func panicMockWithArgsMismatch(mockMethod *mockMethodStruct, expectedArgs string
 		methodName, expectedArgs, receivedArgs))
 }
 
+func panicMockAndCallsFuncMismatch(mockMethod *mockMethodStruct, expectedSig string, receivedSig string) {
+	methodName := stringifyMethodName(mockMethod)
+	tPanicMockSetup(fmt.Sprintf("mock.AndCallsFunc() called with mismatched func signature: \n"+
+		"method  : %s\n"+
+		"func expected  : %s\n"+
+		"func received  : %s\n"+
+		"",
+		methodName, expectedSig, receivedSig))
+}
+
+func panicCallHandlerAlreadyDeclared(srcMethod string) {
+	panicMsg := fmt.Sprintf("\n"+
+		"Cannot call mock.%s within a mock.ToReceive() with an already declared call handler.\n"+
+		"AndCallsOriginal, AndCallsFunc and AndPanics are mutually exclusive.\n",
+		srcMethod)
+	tPanicMockSetup(panicMsg)
+}
+
+func panicAndPanicsNilValue() {
+	panicMsg := fmt.Sprintf("\n" +
+		"mock.AndPanics() called with a nil value.\n" +
+		"A nil panic cannot be told apart from a normal return; give a non-nil value (ie, an error)\n")
+	tPanicMockSetup(panicMsg)
+}
+
+func panicMockWithReturnsMismatch(mockMethod *mockMethodStruct, srcMethod string, expectedReturns string, receivedReturns string) {
+	methodName := stringifyMethodName(mockMethod)
+	tPanicMockSetup(fmt.Sprintf("mock.%s called with mismatched return signature: \n"+
+		"method  : %s\n"+
+		"types expected : %s\n"+
+		"types received : %s\n"+
+		"",
+		srcMethod, methodName, expectedReturns, receivedReturns))
+}
+
+func panicInvalidArgumentMatcher(matcherName string, reason string) {
+	panicMsg := fmt.Sprintf("\n"+
+		"Invalid argument matcher: %s\n"+
+		"reason: %s\n",
+		matcherName, reason)
+	tPanicMockSetup(panicMsg)
+}
+
+func panicInvalidArgIndex(mockMethod *mockMethodStruct, srcMethod string, index int) {
+	tPanicMockSetup(fmt.Sprintf("mock.%s called with an invalid arg index: \n"+
+		"method  : %s\n"+
+		"index   : %d\n"+
+		"params  : %s\n",
+		srcMethod, stringifyMethodName(mockMethod), index, mockMethod.stringifyArgTypes()))
+}
+
+func panicMockSetsArgMismatch(mockMethod *mockMethodStruct, srcMethod string, index int, expected string, received string) {
+	tPanicMockSetup(fmt.Sprintf("mock.%s cannot write through the arg: \n"+
+		"method  : %s\n"+
+		"index   : %d\n"+
+		"expected       : %s\n"+
+		"received       : %s\n"+
+		"Only pointer, slice and map args can be written through\n",
+		srcMethod, stringifyMethodName(mockMethod), index, expected, received))
+}
+
+func panicMockSetsArgFailed(mockMethod *mockMethodStruct, index int, arg interface{}, setter string) {
+	tPanicMockRuntime(fmt.Sprintf("Method called with an arg that cannot be written through: \n"+
+		"method  : %s\n"+
+		"index   : %d\n"+
+		"arg received   : %s\n"+
+		"sets arg with  : %s\n",
+		stringifyMethodName(mockMethod), index, stringifyValue(arg), setter))
+}
+
+func panicMockSetsArgMissing(mockMethod *mockMethodStruct, index int) {
+	tPanicMockRuntime(fmt.Sprintf("Method called without the arg to be written through: \n"+
+		"method  : %s\n"+
+		"index   : %d\n",
+		stringifyMethodName(mockMethod), index))
+}
+
+func panicCaptorParamTypeConflict(m *mockMethodStruct, boundType reflect.Type, paramType reflect.Type) {
+	tPanicMockSetup(fmt.Sprintf("Captor given for parameters of different types: \n"+
+		"method  : %s\n"+
+		"captor type    : <%s>\n"+
+		"parameter type : <%s>\n",
+		stringifyMethodName(m), boundType.String(), paramType.String()))
+}
+
+func panicCaptorNested(m *mockMethodStruct, matcherName string) {
+	tPanicMockSetup(fmt.Sprintf("Captor nested within a combined matcher: \n"+
+		"method  : %s\n"+
+		"matcher : %s\n"+
+		"Captors cannot be given to And, Or or Not; give the Captor as the argument itself\n",
+		stringifyMethodName(m), matcherName))
+}
+
+func panicCaptorEmpty(paramType reflect.Type) {
+	typeName := "unbound"
+	if paramType != nil {
+		typeName = paramType.String()
+	}
+	tPanicMockRuntime(fmt.Sprintf("Captor has not captured any value: \n"+
+		"captor type    : <%s>\n", typeName))
+}
+
+func panicCaptorTypeMismatch(captorName string, wantType reflect.Type, value interface{}) {
+	tPanicMockRuntime(fmt.Sprintf("Captured value is not of the requested type: \n"+
+		"captor  : %s\n"+
+		"type requested : <%s>\n"+
+		"value captured : %s\n",
+		captorName, wantType.String(), stringifyValue(value)))
+}
+
 func panicAsDoubleNotImplemented() {
 	panicMsg := fmt.Sprintf("\n" +
 		"Mock Doubles \"AsDouble()\" cannot currently be implemented.\n" +
@@ -0,0 +1,112 @@
+package monkeymock
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maximum depth of nested values rendered within failure output
+const maxValueRenderDepth = 8
+
+// renders the given value (%#v style) followed by its type, ie `"taco" <string>`
+// - argument matchers are rendered using their own description
+// - errors are rendered using their message
+// - pointers are followed and rendered as &value, rather than as an address
+func stringifyValue(value interface{}) string {
+	if value == nil {
+		return "nil"
+	}
+	if matcher, ok := asArgumentMatcher(value); ok {
+		return matcher.String()
+	}
+	return renderValue(reflect.ValueOf(value), 0, map[uintptr]bool{}) + " <" + reflect.TypeOf(value).String() + ">"
+}
+
+// recursively renders a reflect.Value in a Go-syntax like form
+func renderValue(v reflect.Value, depth int, visited map[uintptr]bool) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+	if depth > maxValueRenderDepth {
+		return "..."
+	}
+	if rendered, ok := renderErrorValue(v); ok {
+		return rendered
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return strconv.Quote(v.String())
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case reflect.Complex64, reflect.Complex128:
+		return fmt.Sprint(v.Complex())
+	case reflect.Interface:
+		if v.IsNil() {
+			return "nil"
+		}
+		return renderValue(v.Elem(), depth, visited)
+	case reflect.Ptr:
+		if v.IsNil() {
+			return "nil"
+		}
+		if visited[v.Pointer()] {
+			return fmt.Sprintf("<cycle %#x>", v.Pointer())
+		}
+		visited[v.Pointer()] = true
+		defer delete(visited, v.Pointer())
+		return "&" + renderValue(v.Elem(), depth+1, visited)
+	case reflect.Struct:
+		fields := make([]string, v.NumField())
+		for i := range fields {
+			fields[i] = v.Type().Field(i).Name + ": " + renderValue(v.Field(i), depth+1, visited)
+		}
+		return v.Type().String() + "{" + strings.Join(fields, ", ") + "}"
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return v.Type().String() + "(nil)"
+		}
+		items := make([]string, v.Len())
+		for i := range items {
+			items[i] = renderValue(v.Index(i), depth+1, visited)
+		}
+		return v.Type().String() + "{" + strings.Join(items, ", ") + "}"
+	case reflect.Map:
+		if v.IsNil() {
+			return v.Type().String() + "(nil)"
+		}
+		entries := make([]string, 0, v.Len())
+		for _, key := range v.MapKeys() {
+			entries = append(entries, renderValue(key, depth+1, visited)+": "+renderValue(v.MapIndex(key), depth+1, visited))
+		}
+		sort.Strings(entries)
+		return v.Type().String() + "{" + strings.Join(entries, ", ") + "}"
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if v.IsNil() {
+			return v.Type().String() + "(nil)"
+		}
+		return fmt.Sprintf("%s(%#x)", v.Type().String(), v.Pointer())
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// renders values implementing error as error("message"), when the value is accessible
+func renderErrorValue(v reflect.Value) (string, bool) {
+	if !v.CanInterface() || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return "", false
+	}
+	err, ok := v.Interface().(error)
+	if !ok {
+		return "", false
+	}
+	return "error(" + strconv.Quote(err.Error()) + ")", true
+}
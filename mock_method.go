@@ -3,8 +3,8 @@ package monkeymock
 import (
 	"fmt"
 	"reflect"
-	"strings"
-	"testing"
+	"sync"
+	"time"
 )
 
 type mockMethodCallInterface interface {
@@ -14,8 +14,28 @@ type mockMethodCallInterface interface {
 type mockMethodContainerStruct struct {
 	mockMethodSetupInterface
 
-	lastmockMethodStructPtr *mockMethodStruct     // pointer to the last referenced mockMethod
-	mockMethodPtrs          [](*mockMethodStruct) // ordered list of mockMethod declarations
+	lastmockMethodStructPtr *mockMethodStruct               // pointer to the last referenced mockMethod
+	mockMethodPtrs          [](*mockMethodStruct)           // ordered list of mockMethod declarations
+	mockMethodPtrsLock      sync.RWMutex                    // guards mockMethodPtrs, which partial intercepts may read from any goroutine
+	unexpectedCallRecords   [](*unexpectedCallRecordStruct) // calls whose arguments matched no mockMethod declaration
+}
+
+// guards the call records of every Mock (callRecords, unexpectedCallRecords and the fields of
+// each record), as calls may arrive from any goroutine and dispatch compares calls across Mocks
+var gCallRecordsLock sync.Mutex
+
+// runs fn while holding the call records lock
+func withCallRecordsLock(fn func()) {
+	gCallRecordsLock.Lock()
+	defer gCallRecordsLock.Unlock()
+	fn()
+}
+
+// returns a snapshot of the mockMethod declarations, in declaration order
+func (m *mockStruct) mockMethods() [](*mockMethodStruct) {
+	m.mockMethodPtrsLock.RLock()
+	defer m.mockMethodPtrsLock.RUnlock()
+	return append([](*mockMethodStruct)(nil), m.mockMethodPtrs...)
 }
 
 type mockMethodStruct struct {
@@ -23,26 +43,119 @@ type mockMethodStruct struct {
 	methodName       string
 
 	// callable tracking
-	callCountExpected     int                 // times this mockMethod was expected to be called (defaults to 0)
-	expectedArgsValues    methodArgumentsList //
-	expectedArgsAny       bool
-	expectedReturnsValues methodReturnsList
-	callRecords           [](*callRecordStruct)
-	callOriginal          bool // when true, indicates the original method implementation should be called by the Mock
+	callCountExpected       callCardinality     // times this mockMethod was expected to be called (defaults to any number of times)
+	expectedArgsValues      methodArgumentsList //
+	expectedArgsAny         bool
+	expectedReturnsSequence [](methodReturnsList) // return values for consecutive calls (WithReturns declares a sequence of one)
+	returnsExhausted        ExhaustedReturns      // behaviour once every entry of expectedReturnsSequence has been used
+	callRecords             [](*callRecordStruct)
+	callOriginal            bool          // when true, indicates the original method implementation should be called by the Mock
+	callFunc                reflect.Value // when valid, a custom handler func to be called by the Mock (AndCallsFunc)
+	callPanics              bool          // when true, the Mock panics with callPanicValue (AndPanics)
+	callPanicValue          interface{}
+	expectsPanic            bool                  // when true, every call is expected to panic (ExpectsPanic)
+	orderedAfter            [](*mockMethodStruct) // every call must come after every call to these expectations (InOrder, After)
+	argSetters              []argSetterStruct     // output args to fill in before returning (AndSetsArg, AndSetsArgFunc)
 }
 
 type methodArgumentsList []interface{}
 type methodReturnsList []interface{}
 type callRecordStruct struct {
 	givenArgs       methodArgumentsList
-	receivedReturns methodReturnsList
+	receivedReturns methodReturnsList // values produced by the original or custom handler (AndCallsOriginal, AndCallsFunc)
+	expectedReturns methodReturnsList // values declared for this call (WithReturns), if any
+	returnedValues  methodReturnsList // values handed back to the caller
+	panicked        bool              // true if the call panicked (AndPanics, or a panic from the original/custom handler)
+	panicValue      interface{}       // the recovered panic value, when panicked
+	sequence        uint64            // global call sequence number, ordering calls across all Mocks
+	timestamp       time.Time         // when the call was received
+	duration        time.Duration     // how long the call took to complete (or panic)
+	goroutineID     uint64            // ID of the calling goroutine
+}
+
+// a call whose arguments matched no declared expectation
+type unexpectedCallRecordStruct struct {
+	callRecordStruct
+	methodName string
+	closest    *mockMethodStruct // the declared expectation that came closest to matching
+}
+
+// runs the given call handler, recording any panic it raises before letting it continue on up the stack
+func (c *callRecordStruct) recordPanics(handler func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			withCallRecordsLock(func() {
+				c.panicked = true
+				c.panicValue = r
+			})
+			panic(r)
+		}
+	}()
+	handler()
+}
+
+// assertions run user code (argument matchers, and their String), which may itself call onto
+// Mocks, so they work from a snapshot of the call records rather than under the lock
+func (m *mockStruct) assertMethods(failures *assertFailures) {
+	var mockMethods [](*mockMethodStruct)
+	var unexpectedCallRecords [](*unexpectedCallRecordStruct)
+	withCallRecordsLock(func() {
+		mockMethods = snapshotMockMethods(m.mockMethods())
+		unexpectedCallRecords = append(unexpectedCallRecords, m.unexpectedCallRecords...)
+	})
+	for _, mockMethodPtr := range mockMethods {
+		mockMethodPtr.assertMethod(failures)
+	}
+	m.assertNoUnexpectedCalls(unexpectedCallRecords, failures)
+}
+
+// returns copies of the given mockMethods (and of the prerequisites they are ordered after),
+// each holding a copy of its call records as they stand
+// - callers must hold the call records lock
+func snapshotMockMethods(mockMethods [](*mockMethodStruct)) [](*mockMethodStruct) {
+	snapshots := make(map[*mockMethodStruct]*mockMethodStruct)
+	var snapshot func(m *mockMethodStruct) *mockMethodStruct
+	snapshot = func(m *mockMethodStruct) *mockMethodStruct {
+		if copied, found := snapshots[m]; found {
+			return copied
+		}
+		copied := new(mockMethodStruct)
+		*copied = *m
+		snapshots[m] = copied
+		copied.callRecords = make([](*callRecordStruct), len(m.callRecords))
+		for i, v := range m.callRecords {
+			callRecord := *v
+			copied.callRecords[i] = &callRecord
+		}
+		copied.orderedAfter = make([](*mockMethodStruct), len(m.orderedAfter))
+		for i, v := range m.orderedAfter {
+			copied.orderedAfter[i] = snapshot(v)
+		}
+		return copied
+	}
+	retVal := make([](*mockMethodStruct), len(mockMethods))
+	for i, v := range mockMethods {
+		retVal[i] = snapshot(v)
+	}
+	return retVal
 }
 
-func (m *mockStruct) assertMethods(t *testing.T) {
-	t.Helper()
-	for _, mockMethodPtr := range m.mockMethodPtrs {
-		mockMethodPtr.assertMethod(t)
+func (m *mockStruct) assertNoUnexpectedCalls(unexpectedCallRecords [](*unexpectedCallRecordStruct), failures *assertFailures) {
+	if len(unexpectedCallRecords) == 0 {
+		return
+	}
+	calls := ""
+	for i, v := range unexpectedCallRecords {
+		calls += fmt.Sprintf("          %d: %s\n"+
+			"             args received     : %s\n"+
+			"             closest expectation: %s\n",
+			i+1, stringifyMethodName(v.closest),
+			stringifyValuesList(v.givenArgs), stringifyMethodArgs(v.closest))
 	}
+	failures.add(fmt.Sprintf("Unexpected calls (arguments matched no expectation): \n"+
+		"object  : <%s>\n"+
+		"calls   : \n"+
+		"%s", m.gRefObjectTypeName(), calls))
 }
 
 // times this mockMethod has been called
@@ -50,80 +163,246 @@ func (m *mockMethodStruct) calledCount() int {
 	return len(m.callRecords)
 }
 
-func (m *mockMethodStruct) assertMethod(t *testing.T) {
-	t.Helper()
+func (m *mockMethodStruct) assertMethod(failures *assertFailures) {
 
 	//
 	// t.Errorf("\nyay itsa me!!!!")
 
 	// number of calls
-	m.assertMethodCallCount(t)
+	m.assertMethodCallCount(failures)
+
+	// expected panics
+	m.assertMethodPanics(failures)
+
+	// call order
+	m.assertMethodOrder(failures)
+
+	// real returns versus declared returns
+	m.assertMethodReturns(failures)
+}
+
+func (m *mockMethodStruct) assertMethodReturns(failures *assertFailures) {
+	for i, callRecord := range m.callRecords {
+		if callRecord.expectedReturns == nil || callRecord.receivedReturns == nil {
+			continue // nothing to compare
+		}
+		for j, expected := range callRecord.expectedReturns {
+			if argumentMatches(expected, callRecord.receivedReturns[j]) {
+				continue
+			}
+			methodName := stringifyMethodName(m)
+			failures.add(fmt.Sprintf("Method returned values that differ from WithReturns: \n"+
+				"method  : %s\n"+
+				"call #  : %d\n"+
+				"expected: %s\n"+
+				"actual  : %s", methodName, i+1,
+				stringifyValuesList(callRecord.expectedReturns), stringifyValuesList(callRecord.receivedReturns)))
+			break
+		}
+	}
+}
+
+func (m *mockMethodStruct) assertMethodPanics(failures *assertFailures) {
+	if !m.expectsPanic {
+		return
+	}
+	for i, callRecord := range m.callRecords {
+		if !callRecord.panicked {
+			methodName := stringifyMethodName(m)
+			withargs := stringifyMethodArgs(m)
+			withreturns := stringifyMethodReturns(m)
+			failures.add(fmt.Sprintf("Method expected to panic but returned normally: \n"+
+				"method  : %s\n"+
+				"          withargs   : %s\n"+
+				"          withreturns: %s\n"+
+				"call #  : %d\n"+
+				"calls   : \n"+
+				"%s", methodName, withargs, withreturns, i+1, stringifyMethodCalls(m)))
+		}
+	}
 }
 
 // support calls
 // validate calls
 // assert calls
 
-func (m *mockMethodStruct) assertMethodCallCount(t *testing.T) {
-	t.Helper()
-	switch actualCalls := m.calledCount(); {
-	case m.callCountExpected == 0: // was a 'Maybe' expectation
-	case m.callCountExpected == -1 && actualCalls > 0: // failed 'Never' expectation
-		methodName := stringifyMethodName(m)
-		withargs := stringifyMethodArgs(m)
-		withreturns := stringifyMethodReturns(m)
-		tFail(t, fmt.Sprintf("Method called more than expected: \n"+
-			"method  : %s\n"+
-			"          withargs   : %s\n"+
-			"          withreturns: %s\n"+
-			"expected: Never\n"+
-			"actual  : %d", methodName, withargs, withreturns, actualCalls))
-	case actualCalls > m.callCountExpected: // called more than expected
-		methodName := stringifyMethodName(m)
-		withargs := stringifyMethodArgs(m)
-		withreturns := stringifyMethodReturns(m)
-		tFail(t, fmt.Sprintf("Method called more than expected: \n"+
-			"method  : %s\n"+
-			"          withargs   : %s\n"+
-			"          withreturns: %s\n"+
-			"expected: %d\n"+
-			"actual  : %d", methodName, withargs, withreturns, m.callCountExpected, actualCalls))
-	case actualCalls < m.callCountExpected: // called less than expected
-		methodName := stringifyMethodName(m)
-		withargs := stringifyMethodArgs(m)
-		withreturns := stringifyMethodReturns(m)
-		tFail(t, fmt.Sprintf("Method called less than expected: \n"+
-			"method  : %s\n"+
-			"          withargs   : %s\n"+
-			"          withreturns: %s\n"+
-			"expected: %d\n"+
-			"actual  : %d", methodName, withargs, withreturns, m.callCountExpected, actualCalls))
+func (m *mockMethodStruct) assertMethodCallCount(failures *assertFailures) {
+	actualCalls := m.calledCount()
+	if m.callCountExpected.allows(actualCalls) {
+		return
+	}
+	comparison := "less"
+	if actualCalls > m.callCountExpected.min {
+		comparison = "more"
 	}
+	methodName := stringifyMethodName(m)
+	withargs := stringifyMethodArgs(m)
+	withreturns := stringifyMethodReturns(m)
+	failures.add(fmt.Sprintf("Method called %s than expected: \n"+
+		"method  : %s\n"+
+		"          withargs   : %s\n"+
+		"          withreturns: %s\n"+
+		"expected: %s\n"+
+		"actual  : %s\n"+
+		"calls   : \n"+
+		"%s", comparison, methodName, withargs, withreturns,
+		m.callCountExpected.String(), timesInWords(actualCalls), stringifyMethodCalls(m)))
 }
 
 // Call mocked method instance with the given args.
 // This call mechanism simulates a real call onto a Mock, and may induce
 // a subsequent real call into the underlying object if required.
 // It will trigger the expectations of the Mock, making it a useful for safe-typed mocks.
+// When several expectations exist for the same method, the most specific expectation
+// whose WithArgs values match the given args is used, falling back to the WithAnyArgs
+// expectation (if any).
 func (m *mockStruct) Call(methodName string, args ...interface{}) []interface{} {
 	// find the referenced method -- this includes finging the most appropriate signature
-	for _, mockMethodPtr := range m.mockMethodPtrs {
+	candidates := m.mockMethodsNamed(methodName)
+	if len(candidates) == 0 {
+		panicMockMethodNotFound(getHumanTypeName(m.mockedObjectRef), methodName)
+	}
+	return dispatchMockMethodCall(candidates, args)
+}
+
+// returns every mockMethod declared for the given method name, in declaration order
+func (m *mockStruct) mockMethodsNamed(methodName string) [](*mockMethodStruct) {
+	var retVal [](*mockMethodStruct)
+	for _, mockMethodPtr := range m.mockMethods() {
 		if mockMethodPtr.methodName == methodName {
-			return mockMethodPtr.call(args)
+			retVal = append(retVal, mockMethodPtr)
 		}
 	}
-	panicMockMethodNotFound(getHumanTypeName(m.mockedObjectRef), methodName)
-	return []interface{}{false} // satisfying pedantic compiler; this line is never reached...
+	return retVal
 }
 
-// enact a call against a specific mockMethod
-func (m *mockMethodStruct) call(args methodArgumentsList) methodReturnsList {
-	var retVals methodReturnsList
+// selects the best matching mockMethod from the candidates and enacts the call against it,
+// or panics if no candidate accepts the given args
+// - argument matchers are user code (which may itself call onto Mocks), so they run before taking the lock
+// - selection and recording are one step, so concurrent calls count against the expectation they reach
+func dispatchMockMethodCall(candidates [](*mockMethodStruct), args methodArgumentsList) methodReturnsList {
+	args = candidates[0].normalizeCallArgs(args)
+	matching := matchingMockMethodsForArgs(candidates, args)
+	var closest *mockMethodStruct
+	if len(matching) == 0 {
+		closest = closestMockMethodForArgs(candidates, args)
+	}
+	var mockMethod *mockMethodStruct
+	var callRecord *callRecordStruct
+	var callIndex int
+	withCallRecordsLock(func() {
+		mockMethod = selectMockMethodForArgs(matching)
+		if mockMethod == nil {
+			recordUnexpectedCall(closest, args)
+			return
+		}
+		callIndex, callRecord = mockMethod.recordCall(args)
+	})
+	if mockMethod == nil {
+		panicMockMethodNoMatchingArgs(candidates, args)
+	}
+	return mockMethod.call(callIndex, callRecord, args)
+}
 
-	// record the call and given args
+// records a call that matched none of the candidates onto the Mock of the closest candidate,
+// so that it can be reported by AssertExpectations
+// - callers must hold the call records lock
+func recordUnexpectedCall(closest *mockMethodStruct, args methodArgumentsList) {
+	callRecord := new(unexpectedCallRecordStruct)
+	callRecord.sequence = nextCallSequence()
+	callRecord.givenArgs = copyInterfaceList(args)
+	callRecord.methodName = closest.methodName
+	callRecord.closest = closest
+	parent := closest.parentMockStruct
+	parent.unexpectedCallRecords = append(parent.unexpectedCallRecords, callRecord)
+}
+
+// returns the candidate whose argument expectations match the most of the given args
+// (earliest declaration wins a tie)
+func closestMockMethodForArgs(candidates [](*mockMethodStruct), args methodArgumentsList) *mockMethodStruct {
+	closest, closestScore := candidates[0], -1
+	for _, candidate := range candidates {
+		score := 0
+		for i, expected := range candidate.expectedArgsValues {
+			if i < len(args) && argumentMatches(expected, args[i]) {
+				score++
+			}
+		}
+		if score > closestScore {
+			closest, closestScore = candidate, score
+		}
+	}
+	return closest
+}
+
+// returns the candidates whose argument expectations match the given args, in declaration order
+func matchingMockMethodsForArgs(candidates [](*mockMethodStruct), args methodArgumentsList) [](*mockMethodStruct) {
+	var retVal [](*mockMethodStruct)
+	for _, candidate := range candidates {
+		if candidate.matchesArgs(args) {
+			retVal = append(retVal, candidate)
+		}
+	}
+	return retVal
+}
+
+// picks the mockMethod that best fits the call, among the candidates whose argument expectations match
+// - the most specific argument expectation wins (WithArgs over WithAnyArgs)
+// - on a tie, candidates that have not yet reached their expected call count are preferred
+// - any remaining tie goes to the earliest declaration
+// - the pass-through recording of a Spy is only used when nothing else matches
+// - callers must hold the call records lock
+func selectMockMethodForArgs(matching [](*mockMethodStruct)) *mockMethodStruct {
+	var best, spy *mockMethodStruct
+	for _, candidate := range matching {
+		if candidate.parentMockStruct.isSpy {
+			if spy == nil {
+				spy = candidate
+			}
+			continue
+		}
+		if best == nil {
+			best = candidate
+			continue
+		}
+		bestSpecificity, candidateSpecificity := best.argsSpecificity(), candidate.argsSpecificity()
+		if candidateSpecificity > bestSpecificity ||
+			(candidateSpecificity == bestSpecificity && best.isCallCountSaturated() && !candidate.isCallCountSaturated()) {
+			best = candidate
+		}
+	}
+	if best == nil {
+		return spy // Spy recording only answers calls that no declared expectation accepts
+	}
+	return best
+}
+
+// returns true when the mockMethod has already received all of its expected calls
+func (m *mockMethodStruct) isCallCountSaturated() bool {
+	return m.callCountExpected.isSaturatedBy(m.calledCount())
+}
+
+// records a new call with the given args, returning its (zero based) index and record
+// - callers must hold the call records lock
+func (m *mockMethodStruct) recordCall(args methodArgumentsList) (int, *callRecordStruct) {
+	callIndex := len(m.callRecords)
 	callRecord := new(callRecordStruct)
-	m.callRecords = append(m.callRecords, callRecord)
+	callRecord.sequence = nextCallSequence()
+	callRecord.timestamp = time.Now()
+	callRecord.goroutineID = currentGoroutineID()
 	callRecord.givenArgs = copyInterfaceList(args)
+	m.callRecords = append(m.callRecords, callRecord)
+	m.captureArgs(args)
+	return callIndex, callRecord
+}
+
+// enact a (recorded) call against a specific mockMethod
+func (m *mockMethodStruct) call(callIndex int, callRecord *callRecordStruct, args methodArgumentsList) methodReturnsList {
+	var retVals methodReturnsList
+	var receivedReturns methodReturnsList // values produced by the original or custom handler, if any
+	defer func() {
+		withCallRecordsLock(func() { callRecord.duration = time.Since(callRecord.timestamp) })
+	}()
 
 	// if this call was not expected (ie Never) then we should panic now
 	m.panicIfCallExpectedNever()
@@ -131,46 +410,123 @@ func (m *mockMethodStruct) call(args methodArgumentsList) methodReturnsList {
 	// validate args against method signature
 	m.validateMethodCallArgsSignature(args) // TODO: fix this
 
-	// if no declared return pattern and not AndCallsOriginal or AndCallsFunc, needs to panic now
-	if !m.callOriginal {
-		if len(m.expectedReturnsValues) == 0 { // no viable returns values!!!
+	// find the declared return values for this call (if any)
+	expectedReturns, hasExpectedReturns := m.expectedReturnsForCall(callIndex)
+	// once exhausted, CallOriginalWhenExhausted hands the call to the original alone (in place of AndCallsFunc/AndPanics)
+	fallsBackToOriginal := m.isReturnsSequenceExhausted(callIndex) && m.returnsExhausted == CallOriginalWhenExhausted
+	callOriginal := m.callOriginal || fallsBackToOriginal
+
+	// if no declared return pattern and not AndCallsOriginal, AndCallsFunc or AndPanics, needs to panic now
+	if !callOriginal && !m.callFunc.IsValid() && !m.callPanics {
+		if !hasExpectedReturns && len(m.getObjectMethodReturnTypes()) > 0 { // no viable returns values!!!
 			panicMockMethodReturnsNotDefined(stringifyMethodName(m))
 		}
 	}
 
 	// should fall through to original function?
-	if m.callOriginal {
+	if callOriginal {
 		// more handy local variable ref
 		objectRef := m.parentMockStruct.mockedObjectRef
 
 		// get a usable method handle
 		methodHandle := getObjectMethodByName(objectRef, m.methodName)
 
-		// run the actual method call and try not to blow up
-		retVals = callObjectMethodByName(methodHandle, objectRef, args)
+		// run the actual method call (past any partial intercept) and try not to blow up
+		callRecord.recordPanics(func() {
+			callWithPartialObjectMethodInterceptLifted(reflect.TypeOf(objectRef), m.methodName, func() {
+				retVals = callObjectMethodByName(methodHandle, objectRef, args)
+			})
+		})
 
 		// capture the return values from the function
-		callRecord.receivedReturns = copyInterfaceList(retVals)
+		receivedReturns = copyInterfaceList(retVals)
+		withCallRecordsLock(func() { callRecord.receivedReturns = receivedReturns })
 	}
 
 	// should fall through to custom handler function?
-	//   monkeymock protect needed?
+	if m.callFunc.IsValid() && !fallsBackToOriginal {
+		callRecord.recordPanics(func() {
+			retVals = callFuncWithArgs(m.callFunc, args)
+		})
+
+		// capture the return values from the function
+		receivedReturns = copyInterfaceList(retVals)
+		withCallRecordsLock(func() { callRecord.receivedReturns = receivedReturns })
+	}
+
+	// should panic on purpose?
+	if m.callPanics && !fallsBackToOriginal {
+		callRecord.recordPanics(func() {
+			panic(m.callPanicValue)
+		})
+	}
+
+	// fill in any output args (AndSetsArgFunc handlers may panic too)
+	callRecord.recordPanics(func() {
+		m.applyArgSetters(args)
+	})
 
 	// has expected return?
 	//   yes - give expected, log actual
 	//   no - give what we really received
-	if len(m.expectedReturnsValues) > 0 {
-		retVals = copyInterfaceList(m.expectedReturnsValues)
+	if hasExpectedReturns {
+		retVals = m.mergeExpectedReturns(expectedReturns, receivedReturns)
 	}
 
 	// return []interface{}{false, false}
 	// return []interface{}{7}
+	withCallRecordsLock(func() {
+		if hasExpectedReturns {
+			callRecord.expectedReturns = copyInterfaceList(expectedReturns)
+		}
+		callRecord.returnedValues = copyInterfaceList(retVals)
+	})
+	return retVals
+}
+
+// builds the values handed back to the caller when return values are declared
+// - declared literal values override the real return values
+// - real return values pass through wherever a matcher was declared
+func (m *mockMethodStruct) mergeExpectedReturns(expectedReturns methodReturnsList, receivedReturns methodReturnsList) methodReturnsList {
+	retVals := copyInterfaceList(expectedReturns)
+	for i, v := range expectedReturns {
+		if _, isMatcher := asArgumentMatcher(v); isMatcher {
+			if receivedReturns == nil {
+				panicMockReturnsMatcherWithoutHandler(m)
+			}
+			retVals[i] = receivedReturns[i]
+		}
+	}
 	return retVals
 }
 
+// returns the declared return values for the call at the given (zero based) index
+// - consecutive calls walk through the declared returns sequence
+// - once the sequence is exhausted, the ExhaustedReturns behaviour decides the outcome
+func (m *mockMethodStruct) expectedReturnsForCall(callIndex int) (methodReturnsList, bool) {
+	if len(m.expectedReturnsSequence) == 0 {
+		return nil, false
+	}
+	if !m.isReturnsSequenceExhausted(callIndex) {
+		return m.expectedReturnsSequence[callIndex], true
+	}
+	switch m.returnsExhausted {
+	case CallOriginalWhenExhausted:
+		return nil, false
+	case FailWhenExhausted:
+		panicMockMethodReturnsExhausted(m, callIndex+1)
+	}
+	return m.expectedReturnsSequence[len(m.expectedReturnsSequence)-1], true // RepeatLastReturns
+}
+
+// returns true if every entry of a declared returns sequence has been used up before the given call index
+func (m *mockMethodStruct) isReturnsSequenceExhausted(callIndex int) bool {
+	return len(m.expectedReturnsSequence) > 0 && callIndex >= len(m.expectedReturnsSequence)
+}
+
 //
 func (m *mockMethodStruct) panicIfCallExpectedNever() {
-	if m.callCountExpected == -1 {
+	if m.callCountExpected.isNever() {
 		panicMockMethodCalledButNeverExpected(m)
 	}
 }
@@ -184,30 +540,14 @@ func (m *mockMethodStruct) reflectMethodType() reflect.Type {
 }
 
 func (m *mockMethodStruct) validateMethodCallArgsSignature(args methodArgumentsList) {
-	givenArgsSigStr := typeListToString(args)
-
-	// generate argsSig from mockMethodStruct ptr
-	var realArgSig []string
-	{
-		methodType := m.reflectMethodType()
-		iMax := methodType.NumIn()
-		for i := 0; iMax > i; i++ {
-			inArg := methodType.In(i)
-			realArgSig = append(realArgSig, inArg.String())
-		}
-	}
-	// drop the first arg (it will always be a ref-ptr to the object's struct)
-	realArgSig = realArgSig[1:]
-
-	// generate a sig string
-	realArgSigStr := ""
-	for _, v := range realArgSig {
-		realArgSigStr += "<" + v + ">, "
+	expectedArgs := m.getObjectMethodArgTypes()
+	valid := len(args) == len(expectedArgs)
+	for i := 0; valid && i < len(args); i++ {
+		valid = isValueValidForType(args[i], expectedArgs[i])
 	}
 
 	// panic on mismatch
-	realArgSigStr = strings.Trim(realArgSigStr, " ,")
-	if realArgSigStr != givenArgsSigStr {
-		panicMockMethodCallInvalidArgsSignature(m, realArgSigStr, givenArgsSigStr)
+	if !valid {
+		panicMockMethodCallInvalidArgsSignature(m, m.stringifyArgTypes(), typeListToString(args))
 	}
 }
@@ -0,0 +1,81 @@
+package monkeymock
+
+import (
+	"fmt"
+)
+
+// callCardinality describes the permitted number of calls to a mockMethod, as an inclusive range
+type callCardinality struct {
+	min int // fewest calls permitted
+	max int // most calls permitted, or unboundedCalls
+}
+
+const unboundedCalls = -1
+
+// exactly count calls (Once, Twice, Times, Never)
+func exactCalls(count int) callCardinality {
+	return callCardinality{min: count, max: count}
+}
+
+// zero or more calls (Maybe, AnyNumberOfTimes)
+func anyNumberOfCalls() callCardinality {
+	return callCardinality{min: 0, max: unboundedCalls}
+}
+
+// count or more calls (AtLeast)
+func atLeastCalls(count int) callCardinality {
+	return callCardinality{min: count, max: unboundedCalls}
+}
+
+// zero through count calls (AtMost)
+func atMostCalls(count int) callCardinality {
+	return callCardinality{min: 0, max: count}
+}
+
+// min through max calls (Between)
+func betweenCalls(min int, max int) callCardinality {
+	return callCardinality{min: min, max: max}
+}
+
+// returns true if the given number of calls falls within the permitted range
+func (c callCardinality) allows(count int) bool {
+	return count >= c.min && (c.max == unboundedCalls || count <= c.max)
+}
+
+// returns true if the given number of calls has reached the upper limit of the permitted range
+func (c callCardinality) isSaturatedBy(count int) bool {
+	return c.max != unboundedCalls && count >= c.max
+}
+
+// returns true if no calls at all are permitted
+func (c callCardinality) isNever() bool {
+	return c.max == 0
+}
+
+// describes the permitted range in plain words (ie, "exactly once", "between 2 and 4 times")
+func (c callCardinality) String() string {
+	switch {
+	case c.isNever():
+		return "never"
+	case c.min == c.max:
+		return "exactly " + timesInWords(c.min)
+	case c.max == unboundedCalls && c.min == 0:
+		return "any number of times"
+	case c.max == unboundedCalls:
+		return "at least " + timesInWords(c.min)
+	case c.min == 0:
+		return "at most " + timesInWords(c.max)
+	}
+	return fmt.Sprintf("between %d and %d times", c.min, c.max)
+}
+
+// renders a call count in plain words (ie, "once", "twice", "3 times")
+func timesInWords(count int) string {
+	switch count {
+	case 1:
+		return "once"
+	case 2:
+		return "twice"
+	}
+	return fmt.Sprintf("%d times", count)
+}
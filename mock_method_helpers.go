@@ -1,6 +1,7 @@
 package monkeymock
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 )
@@ -11,12 +12,63 @@ func stringifyMethodName(m *mockMethodStruct) string {
 	return methodName
 }
 
+// renders the declared return values of the mockMethod (or where they will come from)
 func stringifyMethodReturns(m *mockMethodStruct) string {
-	return "value <type>"
+	switch {
+	case len(m.expectedReturnsSequence) == 1:
+		return stringifyValuesList(m.expectedReturnsSequence[0])
+	case len(m.expectedReturnsSequence) > 1:
+		sets := make([]string, len(m.expectedReturnsSequence))
+		for i, v := range m.expectedReturnsSequence {
+			sets[i] = "(" + stringifyValuesList(v) + ")"
+		}
+		return strings.Join(sets, " then ")
+	case m.callOriginal:
+		return "(returns of the original method)"
+	case m.callFunc.IsValid():
+		return "(returns of AndCallsFunc)"
+	case m.callPanics:
+		return "(panics with " + stringifyValue(m.callPanicValue) + ")"
+	}
+	return "(none declared)"
 }
 
+// renders the declared argument values and matchers of the mockMethod
 func stringifyMethodArgs(m *mockMethodStruct) string {
-	return "value <type>, value <type>, value <type>"
+	if !m.hasArgsExpectation() {
+		return "(any args)"
+	}
+	return stringifyValuesList(m.expectedArgsValues)
+}
+
+// renders each actual call received by the mockMethod, along with its args and returns
+func stringifyMethodCalls(m *mockMethodStruct) string {
+	if len(m.callRecords) == 0 {
+		return "          (no calls)\n"
+	}
+	calls := ""
+	for i, v := range m.callRecords {
+		calls += fmt.Sprintf("          %d: args: %s\n", i+1, stringifyValuesList(v.givenArgs))
+		if v.panicked {
+			calls += fmt.Sprintf("             panicked: %s\n", stringifyValue(v.panicValue))
+			continue
+		}
+		calls += fmt.Sprintf("             returned: %s\n", stringifyValuesList(v.returnedValues))
+	}
+	return calls
+}
+
+// renders a list of argument (or return) values along with their types
+// - argument matchers are rendered using their own description
+func stringifyValuesList(values []interface{}) string {
+	if len(values) == 0 {
+		return "(none)"
+	}
+	rendered := make([]string, len(values))
+	for i, v := range values {
+		rendered[i] = stringifyValue(v)
+	}
+	return strings.Join(rendered, ", ")
 }
 
 // func stringifyValuesList(typeList []reflect.Value) string {
@@ -67,6 +119,44 @@ func (m *mockMethodStruct) getObjectMethodArgTypes() []reflect.Type {
 	return []reflect.Type{}
 }
 
+// returns the result types of the mocked method
+func (m *mockMethodStruct) getObjectMethodReturnTypes() []reflect.Type {
+	methodPtr := getObjectMethodByName(m.parentMockStruct.mockedObjectRef, m.methodName)
+	if methodPtr != nil {
+		methodPtrType := methodPtr.Type
+		num := methodPtrType.NumOut()
+		retVal := make([]reflect.Type, num)
+		for i := 0; i < num; i++ {
+			retVal[i] = methodPtrType.Out(i)
+		}
+		return retVal
+	}
+	return []reflect.Type{}
+}
+
+// throw a panic if the given func does not share the exact signature of the mocked method (sans receiver)
+func (m *mockMethodStruct) ensureMethodFunc(fn interface{}) {
+	expectedSig := reflect.FuncOf(m.getObjectMethodArgTypes(), m.getObjectMethodReturnTypes(), m.isVariadic())
+	fnType := reflect.TypeOf(fn)
+	if fnType == nil || fnType.Kind() != reflect.Func {
+		panicMockAndCallsFuncMismatch(m, expectedSig.String(), getHumanTypeName(fn))
+	}
+	if fnType.NumIn() != expectedSig.NumIn() || fnType.NumOut() != expectedSig.NumOut() || fnType.IsVariadic() != expectedSig.IsVariadic() {
+		panicMockAndCallsFuncMismatch(m, expectedSig.String(), fnType.String())
+	}
+	for i := 0; i < fnType.NumIn(); i++ {
+		if fnType.In(i) != expectedSig.In(i) {
+			panicMockAndCallsFuncMismatch(m, expectedSig.String(), fnType.String())
+		}
+	}
+	for i := 0; i < fnType.NumOut(); i++ {
+		if fnType.Out(i) != expectedSig.Out(i) {
+			panicMockAndCallsFuncMismatch(m, expectedSig.String(), fnType.String())
+		}
+	}
+	// seems good, carry on
+}
+
 func getArgsListTypes(args methodArgumentsList) []reflect.Type {
 	retVal := make([]reflect.Type, len(args))
 	for i, v := range args {
@@ -75,39 +165,222 @@ func getArgsListTypes(args methodArgumentsList) []reflect.Type {
 	return retVal
 }
 
+// renders the types of an args (or returns) list, substituting the description of any argument matchers
+func stringifyValuesTypesList(values []interface{}) string {
+	listTypeSig := ""
+	for _, v := range values {
+		if matcher, ok := asArgumentMatcher(v); ok {
+			listTypeSig += matcher.String() + ", "
+			continue
+		}
+		listTypeSig += "<" + getHumanTypeName(v) + ">, "
+	}
+	return strings.Trim(listTypeSig, " ,")
+}
+
 // throw a panic if the given args list does not match the method signature
+// - argument matchers are accepted in place of any argument value
+// - any Captor is bound to the parameter type of its slot
+// - the variadic args of a variadic method may be given flattened, or as a single slice value
 func (m *mockMethodStruct) ensureMethodArgs(args methodArgumentsList) {
 	expectedArgs := m.getObjectMethodArgTypes()
-	// log.Print(expectedArgs)
 	givenArgs := getArgsListTypes(args)
-	// log.Print(givenArgs)
+
+	if m.isVariadic() {
+		m.ensureVariadicMethodArgs(args)
+		return
+	}
 
 	if len(expectedArgs) != len(givenArgs) {
-		// panic
-		panicMockWithArgsMismatch(m, stringifyTypesList(expectedArgs), "receivedArgs string")
-		// mockMethod *mockMethodStruct, expectedArgs string, receivedArgs string
+		panicMockWithArgsMismatch(m, stringifyTypesList(expectedArgs), stringifyValuesTypesList(args))
 	}
 	for i, v := range expectedArgs {
-		if givenArgs[i] != v {
-			// panic
-			// panicMockWithArgsMismatch
-			panicMockWithArgsMismatch(m, stringifyTypesList(expectedArgs), stringifyTypesList(givenArgs))
+		if _, isMatcher := asArgumentMatcher(args[i]); isMatcher {
+			m.bindCaptor(args[i], v)
+			continue // matchers are type checked by their own Matches()
+		}
+		if !isValueValidForType(args[i], v) {
+			panicMockWithArgsMismatch(m, stringifyTypesList(expectedArgs), stringifyValuesTypesList(args))
+		}
+	}
+	// seems good, carry on
+}
+
+// throw a panic if the given args list does not match the signature of a variadic method
+func (m *mockMethodStruct) ensureVariadicMethodArgs(args methodArgumentsList) {
+	expectedArgs := m.getObjectMethodArgTypes()
+	numFixed := len(expectedArgs) - 1
+
+	if len(args) < numFixed {
+		panicMockWithArgsMismatch(m, m.stringifyArgTypes(), stringifyValuesTypesList(args))
+	}
+	for i := 0; i < numFixed; i++ {
+		if _, isMatcher := asArgumentMatcher(args[i]); isMatcher {
+			m.bindCaptor(args[i], expectedArgs[i])
+			continue // matchers are type checked by their own Matches()
+		}
+		if !isValueValidForType(args[i], expectedArgs[i]) {
+			panicMockWithArgsMismatch(m, m.stringifyArgTypes(), stringifyValuesTypesList(args))
+		}
+	}
+	elemType := expectedArgs[numFixed].Elem()
+	for _, v := range m.variadicElements(args) {
+		if _, isMatcher := asArgumentMatcher(v); isMatcher {
+			m.bindCaptor(v, elemType)
+			continue
+		}
+		if !isValueValidForType(v, elemType) {
+			panicMockWithArgsMismatch(m, m.stringifyArgTypes(), stringifyValuesTypesList(args))
 		}
 	}
 	// seems good, carry on
 }
 
+// renders the parameter types of the mocked method, with any variadic parameter as <...T>
+func (m *mockMethodStruct) stringifyArgTypes() string {
+	argTypes := m.getObjectMethodArgTypes()
+	if !m.isVariadic() {
+		return stringifyTypesList(argTypes)
+	}
+	last := len(argTypes) - 1
+	variadic := "<..." + argTypes[last].Elem().String() + ">"
+	if last == 0 {
+		return variadic
+	}
+	return stringifyTypesList(argTypes[:last]) + ", " + variadic
+}
+
+// throw a panic if the given returns list does not match the method result signature
+// - argument matchers are accepted in place of any return value
+// - otherwise, each value must be valid for its result type (see isValueValidForType)
+func (m *mockMethodStruct) ensureMethodReturns(srcMethod string, returns methodReturnsList) {
+	expectedReturns := m.getObjectMethodReturnTypes()
+
+	if len(expectedReturns) != len(returns) {
+		panicMockWithReturnsMismatch(m, srcMethod, stringifyTypesList(expectedReturns), stringifyValuesTypesList(returns))
+	}
+	for i, v := range expectedReturns {
+		if !isReturnValueValidForType(returns[i], v) {
+			panicMockWithReturnsMismatch(m, srcMethod, stringifyTypesList(expectedReturns), stringifyValuesTypesList(returns))
+		}
+	}
+	// seems good, carry on
+}
+
+func isReturnValueValidForType(value interface{}, resultType reflect.Type) bool {
+	if _, isMatcher := asArgumentMatcher(value); isMatcher {
+		return true // matchers verify real return values (AndCallsOriginal/AndCallsFunc), checked by their own Matches()
+	}
+	return isValueValidForType(value, resultType)
+}
+
+// returns the given returns list with each value converted to its exact result type (see convertValuesToTypes)
+func (m *mockMethodStruct) typedReturns(returns methodReturnsList) methodReturnsList {
+	return convertValuesToTypes(returns, m.getObjectMethodReturnTypes())
+}
+
+// returns true if the given value may stand for a value of the given type
+// - nil is accepted for nillable types (pointer, interface, slice, map, chan, func)
+// - values assignable to the type are accepted (ie, implementations of an interface type)
+// - numeric values are accepted for another numeric type when the conversion is lossless (ie, 7 for an int64)
+// - string and bool values are accepted for named types of the same kind (ie, "active" for a `type Status string`)
+func isValueValidForType(value interface{}, t reflect.Type) bool {
+	if value == nil {
+		return isNillableKind(t.Kind())
+	}
+	valueType := reflect.TypeOf(value)
+	if valueType.AssignableTo(t) {
+		return true
+	}
+	if valueType.Kind() == t.Kind() && (t.Kind() == reflect.String || t.Kind() == reflect.Bool) {
+		return true // same underlying type, so the conversion is always lossless
+	}
+	if !isNumericKind(valueType.Kind()) || !isNumericKind(t.Kind()) || !valueType.ConvertibleTo(t) {
+		return false
+	}
+	original := reflect.ValueOf(value)
+	if isNegativeNumber(original) && t.Kind() >= reflect.Uint && t.Kind() <= reflect.Uintptr {
+		return false // sign would be lost
+	}
+	roundTrip := original.Convert(t).Convert(valueType)
+	return roundTrip.Interface() == original.Interface()
+}
+
+func isNegativeNumber(v reflect.Value) bool {
+	switch {
+	case v.Kind() >= reflect.Int && v.Kind() <= reflect.Int64:
+		return v.Int() < 0
+	case v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64:
+		return v.Float() < 0
+	}
+	return false
+}
+
+func isNumericKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	}
+	return false
+}
+
+// returns the given value as a reflect.Value of exactly the given type
+// - nil becomes the zero value of the type
+// - values that are not valid for the type (see isValueValidForType) are returned as they are
+func valueOfType(value interface{}, t reflect.Type) reflect.Value {
+	if value == nil {
+		return reflect.Zero(t)
+	}
+	v := reflect.ValueOf(value)
+	if v.Type() == t || !isValueValidForType(value, t) {
+		return v
+	}
+	return v.Convert(t)
+}
+
+// returns a copy of the given values, each converted to the exact type at the same position
+// - nil becomes the typed zero value (ie, (*T)(nil) for a *T)
+// - Eq matchers have their expected value converted likewise (see eqMatcher.ofType)
+// - other argument matchers, and values that are not valid for their type, are copied as they are
+func convertValuesToTypes(values []interface{}, types []reflect.Type) []interface{} {
+	retVals := copyInterfaceList(values)
+	for i, v := range values {
+		if i >= len(types) {
+			break
+		}
+		if eq, isEq := v.(*eqMatcher); isEq {
+			retVals[i] = eq.ofType(types[i])
+			continue
+		}
+		if _, isMatcher := asArgumentMatcher(v); isMatcher {
+			continue
+		}
+		if !isValueValidForType(v, types[i]) {
+			continue
+		}
+		retVals[i] = valueOfType(v, types[i]).Interface()
+	}
+	return retVals
+}
+
 func callObjectMethodByName(methodHandle *reflect.Method, object interface{}, args methodArgumentsList) methodReturnsList {
 	// build the args list
 	in := make([]reflect.Value, len(args)+1)
 	in[0] = reflect.ValueOf(object) // first argument is the reference object itself
-	// the rest are converted in order over to reflect.Value types
+	// the rest are converted in order over to reflect.Value types (of the exact parameter type)
 	for i, v := range args {
-		in[i+1] = reflect.ValueOf(v)
+		in[i+1] = valueOfType(v, methodHandle.Type.In(i+1))
 	}
 
-	// do the call
-	returnedValueArray := methodHandle.Func.Call(in)
+	// do the call (the variadic args of a variadic method arrive as a single slice value)
+	var returnedValueArray []reflect.Value
+	if methodHandle.Type.IsVariadic() {
+		returnedValueArray = methodHandle.Func.CallSlice(in)
+	} else {
+		returnedValueArray = methodHandle.Func.Call(in)
+	}
 
 	// convert the returned Value array into something more generic
 	returnsList := make(methodReturnsList, len(returnedValueArray))
@@ -118,6 +391,31 @@ func callObjectMethodByName(methodHandle *reflect.Method, object interface{}, ar
 	return returnsList
 }
 
+// calls the given func handle with the given args, returning its results
+// - args are passed as the exact parameter type, with nil args as the zero value (see valueOfType)
+func callFuncWithArgs(fn reflect.Value, args methodArgumentsList) methodReturnsList {
+	fnType := fn.Type()
+	in := make([]reflect.Value, len(args))
+	for i, v := range args {
+		in[i] = valueOfType(v, fnType.In(i))
+	}
+
+	// do the call (the variadic args of a variadic func arrive as a single slice value)
+	var returnedValueArray []reflect.Value
+	if fnType.IsVariadic() {
+		returnedValueArray = fn.CallSlice(in)
+	} else {
+		returnedValueArray = fn.Call(in)
+	}
+
+	// convert the returned Value array into something more generic
+	returnsList := make(methodReturnsList, len(returnedValueArray))
+	for i, v := range returnedValueArray {
+		returnsList[i] = v.Interface()
+	}
+	return returnsList
+}
+
 // make a shallow copy of interface an list
 func copyInterfaceList(interfaceList []interface{}) []interface{} {
 	retsList := make([]interface{}, len(interfaceList))
@@ -0,0 +1,76 @@
+package monkeymock
+
+import (
+	"sort"
+	"time"
+)
+
+type mockCallHistoryInterface interface {
+	Calls(methodName string) []CallRecord
+	LastCall(methodName string) (CallRecord, bool)
+	CallCount(methodName string) int
+}
+
+// CallRecord describes a single call received by a Mock, for use within custom assertions.
+// See Mock.Calls, Mock.LastCall and Mock.CallCount.
+type CallRecord struct {
+	Args        []interface{} // args received (the variadic args of a variadic method as a single slice value)
+	Returns     []interface{} // values handed back to the caller (nil if the call panicked)
+	Panic       interface{}   // the recovered panic value, if the call panicked
+	Timestamp   time.Time     // when the call was received
+	Duration    time.Duration // how long the call took, including any original method or AndCallsFunc handler
+	GoroutineID uint64        // ID of the goroutine that made the call
+	Sequence    uint64        // global call sequence number, ordering calls across all Mocks
+}
+
+// Calls returns every call received by the expectations of the named method, in the order
+// they were received. Calls whose arguments matched no expectation are not included.
+// Panics if the mocked object has no such method.
+func (m *mockStruct) Calls(methodName string) []CallRecord {
+	if !objectRefHasMethod(m.mockedObjectRef, methodName) {
+		panicMockMethodNotFound(getHumanTypeName(m.mockedObjectRef), methodName)
+	}
+	var retVal []CallRecord
+	withCallRecordsLock(func() {
+		for _, mockMethod := range m.mockMethodsNamed(methodName) {
+			for _, callRecord := range mockMethod.callRecords {
+				retVal = append(retVal, callRecord.export())
+			}
+		}
+	})
+	sort.Slice(retVal, func(i, j int) bool { return retVal[i].Sequence < retVal[j].Sequence })
+	return retVal
+}
+
+// LastCall returns the most recent call received by the expectations of the named method,
+// or false if there has been no such call. See Calls.
+func (m *mockStruct) LastCall(methodName string) (CallRecord, bool) {
+	calls := m.Calls(methodName)
+	if len(calls) == 0 {
+		return CallRecord{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// CallCount returns the number of calls received by the expectations of the named method. See Calls.
+func (m *mockStruct) CallCount(methodName string) int {
+	return len(m.Calls(methodName))
+}
+
+// returns an exported copy of the call record
+// - callers must hold the call records lock
+func (c *callRecordStruct) export() CallRecord {
+	record := CallRecord{
+		Args:        copyInterfaceList(c.givenArgs),
+		Timestamp:   c.timestamp,
+		Duration:    c.duration,
+		GoroutineID: c.goroutineID,
+		Sequence:    c.sequence,
+	}
+	if c.panicked {
+		record.Panic = c.panicValue
+	} else if c.returnedValues != nil {
+		record.Returns = copyInterfaceList(c.returnedValues)
+	}
+	return record
+}
@@ -0,0 +1,129 @@
+package monkeymock
+
+import (
+	"fmt"
+	"sort"
+	"sync/atomic"
+)
+
+// Expectation is a handle onto a single method expectation (ie, one ToReceive declaration),
+// used to declare the order in which expectations must be called (InOrder, Mock.After).
+//
+// A Mock is itself an Expectation, referring to its most recently declared ToReceive at
+// the moment it is handed over. When declaring several methods on the same Mock, use
+// Mock.Expectation() to take a handle before moving on to the next ToReceive.
+type Expectation interface {
+	expectationRef() *mockMethodStruct
+}
+
+func (m *mockMethodStruct) expectationRef() *mockMethodStruct {
+	return m
+}
+
+func (m *mockStruct) expectationRef() *mockMethodStruct {
+	if m.lastmockMethodStructPtr == nil {
+		panicExpectationDeclaredBeforeToReceive("Expectation()")
+	}
+	return m.lastmockMethodStructPtr
+}
+
+// InOrder declares that the given expectations must be called in the given order, across
+// any number of Mocks. Every call to an expectation must happen after every call to the
+// expectation preceding it. Violations are reported by AssertExpectations.
+//
+//	begin := db.ToReceive("Begin").WithReturns(tx).Expectation()
+//	exec := db.ToReceive("Exec").WithAnyArgs().WithReturns(nil).Expectation()
+//	commit := tx.ToReceive("Commit").WithReturns(nil).Expectation()
+//	monkeymock.InOrder(begin, exec, commit)
+func InOrder(expectations ...Expectation) {
+	var previous *mockMethodStruct
+	for _, v := range expectations {
+		current := v.expectationRef()
+		if previous != nil {
+			current.addOrderedAfter(previous)
+		}
+		previous = current
+	}
+}
+
+// declares that every call to this mockMethod must come after every call to the given prerequisite
+func (m *mockMethodStruct) addOrderedAfter(prerequisite *mockMethodStruct) {
+	if prerequisite == m {
+		return // an expectation cannot be ordered against itself
+	}
+	for _, v := range m.orderedAfter {
+		if v == prerequisite {
+			return // already declared
+		}
+	}
+	m.orderedAfter = append(m.orderedAfter, prerequisite)
+}
+
+///////////////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// global call sequence, shared by every Mock so that calls can be ordered across Mocks
+// - never reset, as Mocks of any Controller may still hold earlier sequence numbers
+var gCallSequence uint64
+
+// returns the next global call sequence number (starting at 1)
+func nextCallSequence() uint64 {
+	return atomic.AddUint64(&gCallSequence, 1)
+}
+
+// returns the lowest and highest call sequence numbers recorded for this mockMethod
+func (m *mockMethodStruct) callSequenceRange() (first uint64, last uint64) {
+	for i, v := range m.callRecords {
+		if i == 0 || v.sequence < first {
+			first = v.sequence
+		}
+		if v.sequence > last {
+			last = v.sequence
+		}
+	}
+	return first, last
+}
+
+func (m *mockMethodStruct) assertMethodOrder(failures *assertFailures) {
+	if len(m.callRecords) == 0 {
+		return // never called, so never out of order
+	}
+	firstCall, _ := m.callSequenceRange()
+	for _, prerequisite := range m.orderedAfter {
+		_, lastPrerequisiteCall := prerequisite.callSequenceRange()
+		if len(prerequisite.callRecords) > 0 && lastPrerequisiteCall < firstCall {
+			continue // all good
+		}
+		failures.add(fmt.Sprintf("Method called out of order: \n"+
+			"method  : %s\n"+
+			"expected after: %s\n"+
+			"actual order  : \n"+
+			"%s",
+			stringifyMethodName(m), stringifyMethodName(prerequisite),
+			stringifyCallOrder(append([](*mockMethodStruct){m}, m.orderedAfter...))))
+	}
+}
+
+// renders every call made to the given mockMethods, in the order they happened
+func stringifyCallOrder(mockMethods [](*mockMethodStruct)) string {
+	type orderedCall struct {
+		sequence   uint64
+		mockMethod *mockMethodStruct
+	}
+	var calls []orderedCall
+	for _, mockMethod := range mockMethods {
+		for _, callRecord := range mockMethod.callRecords {
+			calls = append(calls, orderedCall{callRecord.sequence, mockMethod})
+		}
+	}
+	sort.Slice(calls, func(i, j int) bool { return calls[i].sequence < calls[j].sequence })
+
+	retVal := ""
+	for i, v := range calls {
+		retVal += fmt.Sprintf("          %d: %s\n", i+1, stringifyMethodName(v.mockMethod))
+	}
+	if retVal == "" {
+		retVal = "          (no calls)\n"
+	}
+	return retVal
+}
@@ -0,0 +1,126 @@
+package monkeymock
+
+import (
+	"reflect"
+)
+
+// an output arg to fill in before returning, by either value or func
+type argSetterStruct struct {
+	index int           // zero based index of the arg, as given by the caller (variadic elements included)
+	value interface{}   // value to write through the arg (AndSetsArg)
+	fn    reflect.Value // when valid, func to call with the arg instead (AndSetsArgFunc)
+}
+
+// returns the type of the parameter at the given (zero based) arg index, panicking if there is none
+// - indexes past the fixed params of a variadic method address its variadic elements
+func (m *mockMethodStruct) argTypeAtIndex(srcMethod string, index int) reflect.Type {
+	paramTypes := m.getObjectMethodArgTypes()
+	if m.isVariadic() && index >= len(paramTypes)-1 {
+		return paramTypes[len(paramTypes)-1].Elem()
+	}
+	if index < 0 || index >= len(paramTypes) {
+		panicInvalidArgIndex(m, srcMethod, index)
+	}
+	return paramTypes[index]
+}
+
+// throw a panic if the given value cannot be written through the parameter at the given index
+// - interface parameters are only checked at call time, once the real arg is known
+func (m *mockMethodStruct) ensureSetsArgValue(index int, value interface{}) {
+	paramType := m.argTypeAtIndex("AndSetsArg()", index)
+	if paramType.Kind() == reflect.Interface {
+		return
+	}
+	if !canWriteThroughType(paramType, value) {
+		panicMockSetsArgMismatch(m, "AndSetsArg()", index, paramType.String(), stringifyValue(value))
+	}
+}
+
+// throw a panic if the given func cannot be called with the parameter at the given index
+// - interface parameters are only checked at call time, once the real arg is known
+func (m *mockMethodStruct) ensureSetsArgFunc(index int, fn interface{}) {
+	paramType := m.argTypeAtIndex("AndSetsArgFunc()", index)
+	expectedSig := "func(" + paramType.String() + ")"
+	fnType := reflect.TypeOf(fn)
+	if fnType == nil || fnType.Kind() != reflect.Func {
+		panicMockSetsArgMismatch(m, "AndSetsArgFunc()", index, expectedSig, getHumanTypeName(fn))
+	}
+	if fnType.NumIn() != 1 || fnType.NumOut() != 0 || fnType.IsVariadic() {
+		panicMockSetsArgMismatch(m, "AndSetsArgFunc()", index, expectedSig, fnType.String())
+	}
+	if paramType.Kind() != reflect.Interface && !paramType.AssignableTo(fnType.In(0)) {
+		panicMockSetsArgMismatch(m, "AndSetsArgFunc()", index, expectedSig, fnType.String())
+	}
+}
+
+// returns true if the given value can be written through an arg of the given type
+func canWriteThroughType(argType reflect.Type, value interface{}) bool {
+	switch argType.Kind() {
+	case reflect.Ptr:
+		return isValueValidForType(value, argType.Elem())
+	case reflect.Slice, reflect.Map:
+		return value != nil && isValueValidForType(value, argType)
+	}
+	return false
+}
+
+// fills in the output args of a call, in declaration order
+// - args are in their normalized form (see normalizeCallArgs)
+func (m *mockMethodStruct) applyArgSetters(args methodArgumentsList) {
+	for _, setter := range m.argSetters {
+		arg := m.argAtIndex(args, setter.index)
+		if setter.fn.IsValid() {
+			paramType := setter.fn.Type().In(0)
+			if !isValueValidForType(arg, paramType) {
+				panicMockSetsArgFailed(m, setter.index, arg, "func("+paramType.String()+")")
+			}
+			setter.fn.Call([]reflect.Value{valueOfType(arg, paramType)})
+			continue
+		}
+		if !writeThroughArg(arg, setter.value) {
+			panicMockSetsArgFailed(m, setter.index, arg, stringifyValue(setter.value))
+		}
+	}
+}
+
+// returns the call arg at the given (zero based) index, reaching into the variadic slice when needed
+func (m *mockMethodStruct) argAtIndex(args methodArgumentsList, index int) interface{} {
+	numFixed := len(m.getObjectMethodArgTypes())
+	if m.isVariadic() {
+		numFixed--
+	}
+	if index < numFixed {
+		return args[index]
+	}
+	slice := reflect.ValueOf(args[numFixed])
+	if index-numFixed >= slice.Len() {
+		panicMockSetsArgMissing(m, index)
+	}
+	return slice.Index(index - numFixed).Interface()
+}
+
+// writes the given value through the given pointer, slice or map arg, returning false if not possible
+func writeThroughArg(arg interface{}, value interface{}) bool {
+	target := reflect.ValueOf(arg)
+	if !target.IsValid() || !canWriteThroughType(target.Type(), value) {
+		return false
+	}
+	switch target.Kind() {
+	case reflect.Ptr:
+		if target.IsNil() {
+			return false
+		}
+		target.Elem().Set(valueOfType(value, target.Type().Elem()))
+	case reflect.Slice:
+		reflect.Copy(target, valueOfType(value, target.Type()))
+	case reflect.Map:
+		if target.IsNil() {
+			return false
+		}
+		source := valueOfType(value, target.Type())
+		for _, key := range source.MapKeys() {
+			target.SetMapIndex(key, source.MapIndex(key))
+		}
+	}
+	return true
+}
@@ -1,33 +1,51 @@
 package monkeymock
 
+import (
+	"reflect"
+)
+
 type mockMethodSetupInterface interface {
 	// expectation indicators regarding number of times a method should be called
 	ToReceive(methodName string) Mock
-	Once() Mock           // alias for Times(1)
-	Twice() Mock          // alias for Times(2)
-	Times(count int) Mock // specifies a hard counter for expected number of calls
-	Maybe() Mock          // resets call expectation to unspecified state (zero or more times)
-	Never() Mock          // expects the expectation that the method will never be called
+	Once() Mock                // alias for Times(1)
+	Twice() Mock               // alias for Times(2)
+	Times(count int) Mock      // specifies a hard counter for expected number of calls (Times(0) is the same as Never)
+	Maybe() Mock               // resets call expectation to unspecified state (zero or more times)
+	AnyNumberOfTimes() Mock    // alias for Maybe()
+	Never() Mock               // expects the expectation that the method will never be called
+	AtLeast(count int) Mock    // expects count or more calls
+	AtMost(count int) Mock     // expects zero through count calls
+	Between(min, max int) Mock // expects min through max calls (inclusive)
 	// Calls() int           // returns the number of times the method was called upon
-	// Are we missing flexible call counters? Ie MoreTimesThan and LessTimesThan ??? (maybe addressable by the Calls() counter)
 
 	WithArgs(args ...interface{}) Mock // match the method expectation with a particular set of argument values
 	WithAnyArgs() Mock                 // match the method expectation regardless of argument values (ie, declare a default matcher)
 
-	WithReturns(returnValues ...interface{}) Mock // expect particular return value(s); will override actual return values if also "AndCallsOriginal", but such a case also throws a failure during AssertExpections if the values do not align
+	WithReturns(returnValues ...interface{}) Mock        // expect particular return value(s); will override actual return values if also "AndCallsOriginal", but such a case also throws a failure during AssertExpections if the values do not align
+	WithReturnsInOrder(returnSets ...[]interface{}) Mock // like WithReturns, but each consecutive call receives the next set of return values
+	ThenReturns(returnValues ...interface{}) Mock        // appends another set of return values for the next consecutive call
+	OnReturnsExhausted(behaviour ExhaustedReturns) Mock  // what to do once all declared return sets have been used (defaults to RepeatLastReturns)
+
+	AndCallsOriginal() Mock           // expectation will actually perform a call to the original implementaion
+	AndCallsFunc(fn interface{}) Mock // expectation will call the given func (same signature as the method) to produce return values
+	AndPanics(value interface{}) Mock // expectation will panic with the given value when called
+	ExpectsPanic() Mock               // expects every call to panic (AndPanics, or a panicking original/func); checked by AssertExpectations
 
-	AndCallsOriginal() Mock // expectation will actually perform a call to the original implementaion
+	AndSetsArg(index int, value interface{}) Mock  // writes the given value through the pointer, slice or map arg at index before returning
+	AndSetsArgFunc(index int, fn interface{}) Mock // calls the given func(T) with the arg at index before returning, to fill it in
+
+	Expectation() Expectation               // returns a handle onto the most recently declared ToReceive (for InOrder and After)
+	After(expectations ...Expectation) Mock // expects every call to come after every call to the given expectations
 }
 
 ///////////////////////////////////////////////////////////////////////////////
 ///////////////////////////////////////////////////////////////////////////////
 
-// ToReceive sets up an expectation for a method call
+// ToReceive sets up an expectation for a method call.
+// May be called multiple times on a single Mock; each call begins a new method
+// expectation, and all subsequent setup calls (Once, WithArgs, WithReturns, etc)
+// apply to the most recently declared ToReceive.
 func (m *mockStruct) ToReceive(methodName string) Mock {
-	if m.lastmockMethodStructPtr != nil {
-		panic("ToReceive called multiple times (this usage is NYI)")
-	}
-
 	// validate reference object/type supports method name, or throw a panic
 	if !objectRefHasMethod(m.mockedObjectRef, methodName) {
 		panicMethodNotFoundInObjectRef(getHumanTypeName(m.mockedObjectRef), methodName)
@@ -37,8 +55,10 @@ func (m *mockStruct) ToReceive(methodName string) Mock {
 	newmockMethod := new(mockMethodStruct)
 	newmockMethod.parentMockStruct = m
 	newmockMethod.methodName = methodName
-	newmockMethod.callCountExpected = 0
+	newmockMethod.callCountExpected = anyNumberOfCalls()
+	m.mockMethodPtrsLock.Lock()
 	m.mockMethodPtrs = append(m.mockMethodPtrs, newmockMethod)
+	m.mockMethodPtrsLock.Unlock()
 	m.lastmockMethodStructPtr = newmockMethod
 	return m
 }
@@ -67,7 +87,10 @@ func (m *mockStruct) Times(count int) Mock {
 	if m.lastmockMethodStructPtr == nil {
 		panicExpectationDeclaredBeforeToReceive("Times()")
 	}
-	m.lastmockMethodStructPtr.callCountExpected = count
+	if count < 0 {
+		panicInvalidCallCount("Times()", count)
+	}
+	m.lastmockMethodStructPtr.callCountExpected = exactCalls(count)
 	return m
 }
 
@@ -76,7 +99,16 @@ func (m *mockStruct) Maybe() Mock {
 	if m.lastmockMethodStructPtr == nil {
 		panicExpectationDeclaredBeforeToReceive("Maybe()")
 	}
-	return m.Times(0)
+	m.lastmockMethodStructPtr.callCountExpected = anyNumberOfCalls()
+	return m
+}
+
+// AnyNumberOfTimes - expect the method zero or more times (alias for Maybe)
+func (m *mockStruct) AnyNumberOfTimes() Mock {
+	if m.lastmockMethodStructPtr == nil {
+		panicExpectationDeclaredBeforeToReceive("AnyNumberOfTimes()")
+	}
+	return m.Maybe()
 }
 
 // Never - expect the method to never be called
@@ -84,7 +116,45 @@ func (m *mockStruct) Never() Mock {
 	if m.lastmockMethodStructPtr == nil {
 		panicExpectationDeclaredBeforeToReceive("Never()")
 	}
-	m.lastmockMethodStructPtr.callCountExpected = -1
+	return m.Times(0)
+}
+
+// AtLeast - expect the method count or more times
+func (m *mockStruct) AtLeast(count int) Mock {
+	if m.lastmockMethodStructPtr == nil {
+		panicExpectationDeclaredBeforeToReceive("AtLeast()")
+	}
+	if count < 0 {
+		panicInvalidCallCount("AtLeast()", count)
+	}
+	m.lastmockMethodStructPtr.callCountExpected = atLeastCalls(count)
+	return m
+}
+
+// AtMost - expect the method zero through count times
+func (m *mockStruct) AtMost(count int) Mock {
+	if m.lastmockMethodStructPtr == nil {
+		panicExpectationDeclaredBeforeToReceive("AtMost()")
+	}
+	if count < 0 {
+		panicInvalidCallCount("AtMost()", count)
+	}
+	m.lastmockMethodStructPtr.callCountExpected = atMostCalls(count)
+	return m
+}
+
+// Between - expect the method min through max times (inclusive)
+func (m *mockStruct) Between(min, max int) Mock {
+	if m.lastmockMethodStructPtr == nil {
+		panicExpectationDeclaredBeforeToReceive("Between()")
+	}
+	if min < 0 {
+		panicInvalidCallCount("Between()", min)
+	}
+	if max < min {
+		panicInvalidCallCount("Between()", max)
+	}
+	m.lastmockMethodStructPtr.callCountExpected = betweenCalls(min, max)
 	return m
 }
 
@@ -107,17 +177,12 @@ func (m *mockStruct) WithArgs(args ...interface{}) Mock {
 		panicArgsAlreadyDeclared("WithArgs()")
 	}
 
-	// type check the args list -- will throw panic if they mismatch
-	m.lastmockMethodStructPtr.ensureMethodArgs(args)
-
-	// store a copy of the args list for later reference
-	m.lastmockMethodStructPtr.expectedArgsValues = copyInterfaceList(args)
+	// type check and store a copy of the args list for later reference
+	m.lastmockMethodStructPtr.expectedArgsValues = m.lastmockMethodStructPtr.expectedArgsFor(args)
 
 	return m
 }
 
-// func argsfromVariadic(variadic ...interface{})
-
 // WithAnyArgs - sets an explicit non-expectation regarding arguments.
 // If no known arguments pattern match the current call, the WithAnyArgs pattern will
 // be used as the default handler.
@@ -138,27 +203,104 @@ func (m *mockStruct) WithAnyArgs() Mock {
 ///////////////////////////////////////////////////////////////////////////////
 ///////////////////////////////////////////////////////////////////////////////
 
+// ExhaustedReturns selects what a Mock does once every declared set of return values
+// (WithReturnsInOrder/ThenReturns) has been handed out
+type ExhaustedReturns int
+
+const (
+	// RepeatLastReturns keeps handing out the last declared set of return values (default)
+	RepeatLastReturns ExhaustedReturns = iota
+	// CallOriginalWhenExhausted falls back to the original method implementation (instead of AndCallsFunc/AndPanics)
+	CallOriginalWhenExhausted
+	// FailWhenExhausted panics on any further call
+	FailWhenExhausted
+)
+
 // WithReturns - sets an expectation of a specific return value (or values).
 // If the mock includes `AndCallsOriginal()`, the original method will be called,
 // but the value returned will be replaced with this given expectation. The mismatch
-// will be surfaceable via a call to AssertExpectations
+// will be surfaceable via a call to AssertExpectations.
+// Argument matchers may be given in place of any value to verify the real return
+// values loosely; the real value is then passed through to the caller untouched.
 func (m *mockStruct) WithReturns(returnValues ...interface{}) Mock {
 	if m.lastmockMethodStructPtr == nil {
 		panicExpectationDeclaredBeforeToReceive("WithReturns()")
 	}
 	// panic when args expectation already set
-	if m.lastmockMethodStructPtr.expectedReturnsValues != nil {
+	if m.lastmockMethodStructPtr.expectedReturnsSequence != nil {
 		panicReturnsAlreadyDeclared("WithReturns()")
 	}
 
-	// TODO: validate returns signature
+	// type check the returns list -- will throw panic if they mismatch
+	m.lastmockMethodStructPtr.ensureMethodReturns("WithReturns()", returnValues)
+
+	// store a copy of the returns list for later reference
+	m.lastmockMethodStructPtr.expectedReturnsSequence = [](methodReturnsList){m.lastmockMethodStructPtr.typedReturns(returnValues)}
+
+	return m
+}
+
+// WithReturnsInOrder - sets an expectation of a sequence of return values, one set per call.
+// The first call receives the first set, the second call the second set, and so on.
+// Once the sequence runs out, the OnReturnsExhausted behaviour applies (by default the
+// last set is repeated).
+func (m *mockStruct) WithReturnsInOrder(returnSets ...[]interface{}) Mock {
+	if m.lastmockMethodStructPtr == nil {
+		panicExpectationDeclaredBeforeToReceive("WithReturnsInOrder()")
+	}
+	// panic when returns expectation already set
+	if m.lastmockMethodStructPtr.expectedReturnsSequence != nil {
+		panicReturnsAlreadyDeclared("WithReturnsInOrder()")
+	}
+	if len(returnSets) == 0 {
+		panicReturnsSequenceEmpty("WithReturnsInOrder()")
+	}
+
+	// type check and store a copy of each returns list for later reference
+	sequence := make([](methodReturnsList), len(returnSets))
+	for i, v := range returnSets {
+		m.lastmockMethodStructPtr.ensureMethodReturns("WithReturnsInOrder()", v)
+		sequence[i] = m.lastmockMethodStructPtr.typedReturns(v)
+	}
+	m.lastmockMethodStructPtr.expectedReturnsSequence = sequence
+
+	return m
+}
+
+// ThenReturns - appends another set of return values to those declared by
+// WithReturns (or WithReturnsInOrder), to be handed out on the next consecutive call.
+//
+//	mock.ToReceive("Next").WithReturns(1, nil).ThenReturns(2, nil).ThenReturns(0, io.EOF)
+func (m *mockStruct) ThenReturns(returnValues ...interface{}) Mock {
+	if m.lastmockMethodStructPtr == nil {
+		panicExpectationDeclaredBeforeToReceive("ThenReturns()")
+	}
+	if m.lastmockMethodStructPtr.expectedReturnsSequence == nil {
+		panicReturnsNotYetDeclared("ThenReturns()")
+	}
+
+	// type check the returns list -- will throw panic if they mismatch
+	m.lastmockMethodStructPtr.ensureMethodReturns("ThenReturns()", returnValues)
 
 	// store a copy of the returns list for later reference
-	m.lastmockMethodStructPtr.expectedReturnsValues = copyInterfaceList(returnValues)
+	m.lastmockMethodStructPtr.expectedReturnsSequence = append(m.lastmockMethodStructPtr.expectedReturnsSequence,
+		m.lastmockMethodStructPtr.typedReturns(returnValues))
 
 	return m
 }
 
+// OnReturnsExhausted - selects what happens once every declared set of return values
+// has been handed out: repeat the last set (RepeatLastReturns, the default), fall back to
+// the original method (CallOriginalWhenExhausted), or panic (FailWhenExhausted).
+// Once falling back to the original, any AndCallsFunc handler or AndPanics value is no longer used.
+func (m *mockStruct) OnReturnsExhausted(behaviour ExhaustedReturns) Mock {
+	if m.lastmockMethodStructPtr == nil {
+		panicExpectationDeclaredBeforeToReceive("OnReturnsExhausted()")
+	}
+	m.lastmockMethodStructPtr.returnsExhausted = behaviour
+	return m
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///////////////////////////////////////////////////////////////////////////////
 
@@ -176,9 +318,134 @@ func (m *mockStruct) AndCallsOriginal() Mock {
 	if m.lastmockMethodStructPtr.callOriginal == true {
 		panicReturnsAlreadyDeclared("WithReturns()")
 	}
+	// panic when a custom handler is already set
+	if m.lastmockMethodStructPtr.callFunc.IsValid() || m.lastmockMethodStructPtr.callPanics {
+		panicCallHandlerAlreadyDeclared("AndCallsOriginal()")
+	}
 
 	m.lastmockMethodStructPtr.callOriginal = true
 	return m
 }
 
-// AndCallsFunc -
+// AndCallsFunc - sets up the mock expectation to call the given func when the mock is
+// called, using its results as the method returns. The func must have exactly the
+// parameter and result types of the mocked method (without the receiver), which is
+// checked at setup time. Like AndCallsOriginal, it can be combined with WithReturns()
+// to override the produced return values (mismatches are reported by AssertExpectations).
+func (m *mockStruct) AndCallsFunc(fn interface{}) Mock {
+	if m.lastmockMethodStructPtr == nil {
+		panicExpectationDeclaredBeforeToReceive("AndCallsFunc()")
+	}
+
+	// panic when a call handler is already set
+	if m.lastmockMethodStructPtr.hasCallHandler() {
+		panicCallHandlerAlreadyDeclared("AndCallsFunc()")
+	}
+
+	// type check the func signature -- will throw panic if they mismatch
+	m.lastmockMethodStructPtr.ensureMethodFunc(fn)
+
+	m.lastmockMethodStructPtr.callFunc = reflect.ValueOf(fn)
+	return m
+}
+
+// AndPanics - sets up the mock expectation to panic with the given value when called.
+// The panic is recorded on the call, and can be asserted with ExpectsPanic().
+// The value may not be nil, as a nil panic cannot be told apart from a normal return.
+func (m *mockStruct) AndPanics(value interface{}) Mock {
+	if m.lastmockMethodStructPtr == nil {
+		panicExpectationDeclaredBeforeToReceive("AndPanics()")
+	}
+
+	// a recovered nil is indistinguishable from no panic at all
+	if value == nil {
+		panicAndPanicsNilValue()
+	}
+
+	// panic when a call handler is already set
+	if m.lastmockMethodStructPtr.hasCallHandler() {
+		panicCallHandlerAlreadyDeclared("AndPanics()")
+	}
+
+	m.lastmockMethodStructPtr.callPanics = true
+	m.lastmockMethodStructPtr.callPanicValue = value
+	return m
+}
+
+// ExpectsPanic - sets an expectation that every call to the method panics, whether
+// via AndPanics(), or a panic raised by the original implementation (AndCallsOriginal)
+// or custom handler (AndCallsFunc). Calls that return normally are reported by AssertExpectations.
+func (m *mockStruct) ExpectsPanic() Mock {
+	if m.lastmockMethodStructPtr == nil {
+		panicExpectationDeclaredBeforeToReceive("ExpectsPanic()")
+	}
+	m.lastmockMethodStructPtr.expectsPanic = true
+	return m
+}
+
+// AndSetsArg - sets up the mock expectation to write the given value through one of the
+// args it receives (by zero based index) before returning, for methods that fill in an
+// output parameter (ie, `Decode(v interface{}) error`):
+// - the given value is stored into the variable a pointer arg points at
+// - the elements of the given slice are copied into a slice arg (as with io.Reader)
+// - the entries of the given map are stored into a map arg
+// The value is type checked at setup time against the parameter type, or at call time when the
+// parameter is an interface (or a variadic interface element). Applies after any AndCallsOriginal
+// or AndCallsFunc handler, and may be declared several times.
+func (m *mockStruct) AndSetsArg(index int, value interface{}) Mock {
+	if m.lastmockMethodStructPtr == nil {
+		panicExpectationDeclaredBeforeToReceive("AndSetsArg()")
+	}
+
+	// type check the value against the parameter -- will throw panic if they mismatch
+	m.lastmockMethodStructPtr.ensureSetsArgValue(index, value)
+
+	m.lastmockMethodStructPtr.argSetters = append(m.lastmockMethodStructPtr.argSetters, argSetterStruct{index: index, value: value})
+	return m
+}
+
+// AndSetsArgFunc - like AndSetsArg, but calls the given func with the arg at the given (zero
+// based) index before returning, leaving it to fill the arg in as it pleases. The func must
+// take a single parameter that the arg is assignable to (ie, `func(dest *User)`), and return nothing.
+func (m *mockStruct) AndSetsArgFunc(index int, fn interface{}) Mock {
+	if m.lastmockMethodStructPtr == nil {
+		panicExpectationDeclaredBeforeToReceive("AndSetsArgFunc()")
+	}
+
+	// type check the func signature -- will throw panic if they mismatch
+	m.lastmockMethodStructPtr.ensureSetsArgFunc(index, fn)
+
+	m.lastmockMethodStructPtr.argSetters = append(m.lastmockMethodStructPtr.argSetters, argSetterStruct{index: index, fn: reflect.ValueOf(fn)})
+	return m
+}
+
+// returns true if any call handler (AndCallsOriginal, AndCallsFunc, AndPanics) has been declared
+func (m *mockMethodStruct) hasCallHandler() bool {
+	return m.callOriginal || m.callFunc.IsValid() || m.callPanics
+}
+
+///////////////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// Expectation - returns a handle onto the most recently declared ToReceive, for use
+// with InOrder() and After(). The handle stays bound to that method expectation, even
+// as further ToReceive expectations are declared on the Mock.
+func (m *mockStruct) Expectation() Expectation {
+	if m.lastmockMethodStructPtr == nil {
+		panicExpectationDeclaredBeforeToReceive("Expectation()")
+	}
+	return m.lastmockMethodStructPtr
+}
+
+// After - sets an expectation that every call to the method comes after every call to
+// the given expectations, which may belong to other Mocks. Violations are reported by
+// AssertExpectations.
+func (m *mockStruct) After(expectations ...Expectation) Mock {
+	if m.lastmockMethodStructPtr == nil {
+		panicExpectationDeclaredBeforeToReceive("After()")
+	}
+	for _, v := range expectations {
+		m.lastmockMethodStructPtr.addOrderedAfter(v.expectationRef())
+	}
+	return m
+}
@@ -16,6 +16,16 @@ Beware that if you're using "bou.ke/monkey" in other areas of your tests or
 your application code, you may encounter strange issues if you patch/unpatch
 methods that are also being patched/unpatched by this code.
 
+Original method implementations (AndCallsOriginal, CallOriginalWhenExhausted,
+a Spy, or a call on an object without a Mock) run with the intercept of their
+method briefly lifted, so they work wherever "bou.ke/monkey" does. The catch is
+that only one goroutine may run an intercepted original at a time:
+- originals called from several goroutines at once run one after another
+- while one runs, calls to that same method from other goroutines also reach
+  the original, even on Mock objects
+- an original that waits on another goroutine, which in turn needs to run an
+  intercepted original, deadlocks (ie, fanning out calls to partial objects)
+
 */
 
 import (
@@ -37,15 +47,20 @@ type mockPartialInterceptRecord struct {
 	objectType reflect.Type
 	methodName string
 	patchGuard *monkey.PatchGuard
+	liftDepth  int // nested calls currently running with the patch lifted (see callWithPartialObjectMethodInterceptLifted)
 }
 
 type mockPartialInterceptRecordsMap map[mockPartialInterceptRecordKey]*mockPartialInterceptRecord
 
 var interceptRecords mockPartialInterceptRecordsMap
 
+// guards interceptRecords and every patch/unpatch of the intercepted methods
+// - reentrant, as the original method run while a patch is lifted may itself call other intercepted methods
+var gInterceptLock = newReentrantMutex()
+
 func (m *mockStruct) AsPartial() interface{} {
 	// make sure we have an intercept set up for every method we're currently tracking
-	for _, v := range m.mockMethodPtrs {
+	for _, v := range m.mockMethods() {
 		createPartialObjectMethodIntercept(reflect.TypeOf(m.mockedObjectRef), v.methodName)
 	}
 	return m.mockedObjectRef
@@ -58,6 +73,8 @@ func (m *mockStruct) AsPartial() interface{} {
 // - multiple calls have no cumulative effect (safe to call multiple times)
 // - once the interception is configured, it remains in effect until cleared)
 func createPartialObjectMethodIntercept(objectType reflect.Type, methodName string) {
+	gInterceptLock.Lock()
+	defer gInterceptLock.Unlock()
 	objectPtrType, objectConcreteType := getNormalizedObjectTypes(objectType)
 
 	// check concrete and ptr (in that order) for existing patch
@@ -119,6 +136,8 @@ func getPartialObjectMethodIntercept(objectType reflect.Type, methodName string)
 
 // clears a single object method intercept, specified by the given type and method name
 func clearPartialObjectMethodIntercept(objectType reflect.Type, methodName string) {
+	gInterceptLock.Lock()
+	defer gInterceptLock.Unlock()
 	key := mockPartialInterceptRecordKey{objectType, methodName}
 	record := interceptRecords[key]
 	if record != nil {
@@ -131,47 +150,126 @@ func clearPartialObjectMethodIntercept(objectType reflect.Type, methodName strin
 
 // clears all current known method intercepts
 func clearPartialObjectMethodIntercepts() {
+	gInterceptLock.Lock()
+	defer gInterceptLock.Unlock()
 	for i := range interceptRecords {
 		clearPartialObjectMethodIntercept(i.objectType, i.methodName)
 	}
 }
 
+// clears every intercept that no Mock (of any Controller) still declares against its object type
+func clearUnusedPartialObjectMethodIntercepts() {
+	gInterceptLock.Lock()
+	defer gInterceptLock.Unlock()
+	for key := range interceptRecords {
+		if !isPartialObjectMethodInUse(key.objectType, key.methodName) {
+			clearPartialObjectMethodIntercept(key.objectType, key.methodName)
+		}
+	}
+}
+
+// returns true if any Mock of any active Controller declares the named method against the given object type
+func isPartialObjectMethodInUse(objectType reflect.Type, methodName string) bool {
+	objectPtrType, _ := getNormalizedObjectTypes(objectType)
+	for _, v := range allMocks() {
+		mock := v.(*mockStruct)
+		mockPtrType, _ := getNormalizedObjectTypes(reflect.TypeOf(mock.mockedObjectRef))
+		if mockPtrType == objectPtrType && len(mock.mockMethodsNamed(methodName)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // handles a single method intercept in a generic fashion
 // - if the object/method combination has no mock defition, normal method execution resumes as quickly as possible
 // - if the object/method combination has a mock definition, execution is redirected onto the mock method handler
+// - safe to call from any number of goroutines; patches are only lifted to run the original method
 func handlePartialObjectMethodIntercept(objectType reflect.Type, methodName string, args []reflect.Value) (results []reflect.Value) {
 	// if the object is in the known Mock List, then we need to route all calls through the Mock.Call functionality
-	for _, v := range gTheMockList {
-		if len(args) > 0 && areSameObject(v.(*mockStruct).mockedObjectRef, args[0].Interface()) {
-			// temporarily unpatch, in case there is an expectation to call the original
-			patchRecord := getPartialObjectMethodIntercept(objectType, methodName)
-			patchRecord.patchGuard.Unpatch()
-			defer patchRecord.patchGuard.Restore()
-			// convert inputs
-			interfaceArgs := make([]interface{}, len(args))
-			for i, v := range args {
-				interfaceArgs[i] = v.Interface()
-			}
-			// send this off to the normal Mock Method handler
-			interfaceRets := v.Call(methodName, interfaceArgs[1:]...)
-			// convert outputs
-			retList := make([]reflect.Value, len(interfaceRets))
-			for i, v := range interfaceRets {
-				retList[i] = reflect.ValueOf(v)
-			}
-			return retList
+	if candidates := findMockMethodsForObject(args, methodName); len(candidates) > 0 {
+		// convert inputs
+		interfaceArgs := make([]interface{}, len(args))
+		for i, v := range args {
+			interfaceArgs[i] = v.Interface()
 		}
+		// send this off to the normal Mock Method handler
+		interfaceRets := dispatchMockMethodCall(candidates, interfaceArgs[1:])
+		// convert outputs (to the exact result types, so nil becomes a typed zero value)
+		methodType := args[0].MethodByName(methodName).Type()
+		retList := make([]reflect.Value, len(interfaceRets))
+		for i, v := range interfaceRets {
+			retList[i] = valueOfType(v, methodType.Out(i))
+		}
+		return retList
 	}
 
 	// no Mock registered for this object...
-	patchRecord := getPartialObjectMethodIntercept(objectType, methodName)
-	patchRecord.patchGuard.Unpatch()
-	defer patchRecord.patchGuard.Restore()
 	methodHndl := args[0].MethodByName(methodName)
 	if !methodHndl.IsValid() {
 		panic("could not find the expected method on object: " + methodName)
 	}
-	return methodHndl.Call(args[1:])
+	callWithPartialObjectMethodInterceptLifted(objectType, methodName, func() {
+		if methodHndl.Type().IsVariadic() {
+			results = methodHndl.CallSlice(args[1:]) // the variadic args arrive as a single slice value
+			return
+		}
+		results = methodHndl.Call(args[1:])
+	})
+	return results
+}
+
+// runs fn with the intercept of the given object type and method name (if any) temporarily lifted,
+// so that calls made by fn reach the original method implementation
+// - only one goroutine may lift intercepts at a time; others wait their turn
+// - while lifted, calls to the method from other goroutines run the original, even on Mock objects
+// - fn must not wait on another goroutine that needs to run an intercepted original, or both deadlock
+//
+// The lifted window only opens when an original actually runs (AndCallsOriginal,
+// CallOriginalWhenExhausted, or a call on an object without a Mock), never for calls
+// answered by the Mock itself.
+func callWithPartialObjectMethodInterceptLifted(objectType reflect.Type, methodName string, fn func()) {
+	gInterceptLock.Lock()
+	defer gInterceptLock.Unlock()
+
+	objectPtrType, objectConcreteType := getNormalizedObjectTypes(objectType)
+	record := getPartialObjectMethodIntercept(objectConcreteType, methodName)
+	if record == nil {
+		record = getPartialObjectMethodIntercept(objectPtrType, methodName)
+	}
+	if record == nil {
+		fn() // nothing to lift
+		return
+	}
+
+	if record.liftDepth == 0 {
+		record.patchGuard.Unpatch()
+	}
+	record.liftDepth++
+	defer func() {
+		record.liftDepth--
+		if record.liftDepth == 0 && getPartialObjectMethodIntercept(record.objectType, record.methodName) == record {
+			record.patchGuard.Restore() // only if not cleared in the meantime
+		}
+	}()
+	fn()
+}
+
+// returns every mockMethod declared for the given method name across all Mocks of every active
+// Controller that target the receiver object (args[0]), in declaration order
+func findMockMethodsForObject(args []reflect.Value, methodName string) [](*mockMethodStruct) {
+	if len(args) == 0 {
+		return nil
+	}
+	receiver := args[0].Interface()
+	var retVal [](*mockMethodStruct)
+	for _, v := range allMocks() {
+		mock := v.(*mockStruct)
+		if areSameObject(mock.mockedObjectRef, receiver) {
+			retVal = append(retVal, mock.mockMethodsNamed(methodName)...)
+		}
+	}
+	return retVal
 }
 
 func areSameObject(leftObj interface{}, rightObj interface{}) bool {
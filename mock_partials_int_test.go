@@ -1,6 +1,9 @@
 package monkeymock
 
 import (
+	"bytes"
+	"errors"
+	"io"
 	"testing"
 	"time"
 
@@ -31,6 +34,25 @@ func (m ExamplePartialInternalStruct) ExamplePublicMethod2(trash1 string, trash2
 func (m *ExamplePartialInternalStruct) ExamplePublicMethod3(trash1 string, trash2 int) int {
 	return trash2
 }
+func (m *ExamplePartialInternalStruct) ExampleNillableMethod(w io.Writer) (*ExamplePartialInternalStruct, error) {
+	return m, errors.New("original")
+}
+func (m *ExamplePartialInternalStruct) ExampleVariadicMethod(base int, values ...int) int {
+	for _, v := range values {
+		base += v
+	}
+	return base
+}
+func (m *ExamplePartialInternalStruct) ExampleNestedMethod(value int) int {
+	return m.ExamplePublicMethod("nested", value) + 1
+}
+func (m *ExamplePartialInternalStruct) ExampleDeepStackMethod(value int) int {
+	var scratch [16 * 1024]byte // large enough to grow the stack of a fresh goroutine
+	for i := range scratch {
+		scratch[i] = byte(i)
+	}
+	return value + int(scratch[value%len(scratch)]) - value%256
+}
 
 ///////////////////////////////////////////////////////////////////////////////
 ///////////////////////////////////////////////////////////////////////////////
@@ -46,8 +68,7 @@ type testMockPartialInternals struct {
 }
 
 func (s *testMockPartialInternals) AfterTest(_, _ string) {
-	clearPartialObjectMethodIntercepts() // always clear out existing intercepts
-	ClearExpectations(s.T())             // reset the expectations
+	ClearExpectations(s.T()) // reset the expectations and clear out existing intercepts
 }
 
 func (s *testMockPartialInternals) TestCreatesPartialFromGivenObject() {
@@ -105,3 +126,141 @@ func (s *testMockPartialInternals) TestObjectMethodIntercepts() {
 			"Uncaptured methods on AsPartial objects should continue to execute per normal")
 	}
 }
+
+func (s *testMockPartialInternals) TestPartialWithMultipleMethods() {
+	exampleStruct := &ExamplePartialInternalStruct{}
+	nExpected := gofakeit.Number(100, 199)
+	nExpected3 := gofakeit.Number(200, 299)
+	var _ = Expect(exampleStruct).
+		ToReceive("ExamplePublicMethod").WithReturns(nExpected).
+		ToReceive("ExamplePublicMethod3").WithReturns(nExpected3).
+		AsPartial()
+	assert.Equal(s.T(), nExpected, exampleStruct.ExamplePublicMethod("junk", 1))
+	assert.Equal(s.T(), nExpected3, exampleStruct.ExamplePublicMethod3("junk", 1))
+}
+
+func (s *testMockPartialInternals) TestPartialInterceptFindsMockDeclaringMethod() {
+	exampleStruct := &ExamplePartialInternalStruct{}
+	nExpected := gofakeit.Number(100, 199)
+	nExpected3 := gofakeit.Number(200, 299)
+	var _ = Expect(exampleStruct).ToReceive("ExamplePublicMethod").WithReturns(nExpected).AsPartial()
+	var _ = Expect(exampleStruct).ToReceive("ExamplePublicMethod3").WithReturns(nExpected3).AsPartial()
+	assert.Equal(s.T(), nExpected, exampleStruct.ExamplePublicMethod("junk", 1))
+	assert.Equal(s.T(), nExpected3, exampleStruct.ExamplePublicMethod3("junk", 1))
+}
+
+func (s *testMockPartialInternals) TestPartialDispatchesOnMatchingArgs() {
+	exampleStruct := &ExamplePartialInternalStruct{}
+	var _ = Expect(exampleStruct).ToReceive("ExamplePublicMethod").WithAnyArgs().WithReturns(100).AsPartial()
+	var _ = Expect(exampleStruct).ToReceive("ExamplePublicMethod").WithArgs("junk", 1).WithReturns(101).AsPartial()
+	assert.Equal(s.T(), 101, exampleStruct.ExamplePublicMethod("junk", 1))
+	assert.Equal(s.T(), 100, exampleStruct.ExamplePublicMethod("junk", 2))
+}
+
+func (s *testMockPartialInternals) TestPartialAndCallsFunc() {
+	exampleStruct := &ExamplePartialInternalStruct{}
+	var _ = Expect(exampleStruct).ToReceive("ExamplePublicMethod").
+		AndCallsFunc(func(trash1 string, trash2 int) int { return trash2 * 2 }).
+		AsPartial()
+	assert.Equal(s.T(), 14, exampleStruct.ExamplePublicMethod("junk", 7))
+}
+
+func (s *testMockPartialInternals) TestPartialWithReturnsInOrder() {
+	exampleStruct := &ExamplePartialInternalStruct{}
+	var _ = Expect(exampleStruct).ToReceive("ExamplePublicMethod").
+		WithReturnsInOrder([]interface{}{101}, []interface{}{102}).
+		OnReturnsExhausted(CallOriginalWhenExhausted).
+		AsPartial()
+	assert.Equal(s.T(), 101, exampleStruct.ExamplePublicMethod("junk", 7))
+	assert.Equal(s.T(), 102, exampleStruct.ExamplePublicMethod("junk", 7))
+	assert.Equal(s.T(), 7, exampleStruct.ExamplePublicMethod("junk", 7))
+}
+
+func (s *testMockPartialInternals) TestClearExpectationsUnpatchesPartials() {
+	exampleStruct := &ExamplePartialInternalStruct{}
+	var _ = Expect(exampleStruct).
+		ToReceive("ExamplePublicMethod").WithReturns(100).
+		ToReceive("ExamplePublicMethod2").WithReturns(101).
+		AsPartial()
+	require.Equal(s.T(), 100, exampleStruct.ExamplePublicMethod("junk", 7))
+	require.Len(s.T(), interceptRecords, 2)
+	lastCallSequence := nextCallSequence()
+
+	ClearExpectations(s.T())
+	assert.Zero(s.T(), len(interceptRecords), "every intercept should be cleared")
+	assert.Zero(s.T(), sizeOfMockList(), "every Mock should be cleared")
+	assert.True(s.T(), nextCallSequence() > lastCallSequence, "the call sequence should carry on")
+	assert.Equal(s.T(), 7, exampleStruct.ExamplePublicMethod("junk", 7), "original method should be restored")
+	assert.Equal(s.T(), 7, exampleStruct.ExamplePublicMethod2("junk", 7), "original method should be restored")
+}
+
+func (s *testMockPartialInternals) TestExpectTVerifiesOnCleanup() {
+	fakeT := &FakeT{}
+	exampleStruct := &ExamplePartialInternalStruct{}
+	var _ = ExpectT(fakeT, exampleStruct).ToReceive("ExamplePublicMethod").Once().WithReturns(100).AsPartial()
+	require.Equal(s.T(), 1, sizeOfMockList())
+	assert.False(s.T(), fakeT.Failed(), "nothing is verified before the test ends")
+
+	fakeT.RunCleanups()
+	assert.True(s.T(), fakeT.Failed(), "unmet expectation should fail on cleanup")
+	require.Len(s.T(), fakeT.Errors, 1)
+	assert.Contains(s.T(), fakeT.Errors[0], "ExamplePublicMethod")
+	assert.Zero(s.T(), sizeOfMockList(), "Mock should be dropped on cleanup")
+	assert.Zero(s.T(), len(interceptRecords), "intercepts should be cleared on cleanup")
+	assert.Equal(s.T(), 7, exampleStruct.ExamplePublicMethod("junk", 7), "original method should be restored")
+}
+
+func (s *testMockPartialInternals) TestExpectTKeepsInterceptsInUse() {
+	fakeT := &FakeT{}
+	exampleStruct := &ExamplePartialInternalStruct{}
+	otherStruct := &ExamplePartialInternalStruct{}
+	var _ = ExpectT(fakeT, exampleStruct).ToReceive("ExamplePublicMethod").WithReturns(100).AsPartial()
+	var _ = Expect(otherStruct).ToReceive("ExamplePublicMethod").WithReturns(200).AsPartial()
+	require.Equal(s.T(), 100, exampleStruct.ExamplePublicMethod("junk", 7))
+
+	fakeT.RunCleanups()
+	assert.False(s.T(), fakeT.Failed())
+	assert.Equal(s.T(), 1, sizeOfMockList())
+	assert.Equal(s.T(), 7, exampleStruct.ExamplePublicMethod("junk", 7), "released object should run the original")
+	assert.Equal(s.T(), 200, otherStruct.ExamplePublicMethod("junk", 7), "other Mock should remain intercepted")
+}
+
+func (s *testMockPartialInternals) TestExpectTWithSubtest() {
+	exampleStruct := &ExamplePartialInternalStruct{}
+	s.T().Run("mocked", func(t *testing.T) {
+		var _ = ExpectT(t, exampleStruct).ToReceive("ExamplePublicMethod").Once().WithReturns(100).AsPartial()
+		assert.Equal(t, 100, exampleStruct.ExamplePublicMethod("junk", 7))
+	})
+	assert.Zero(s.T(), sizeOfMockList())
+	assert.Equal(s.T(), 7, exampleStruct.ExamplePublicMethod("junk", 7))
+}
+
+func (s *testMockPartialInternals) TestPartialVariadicMethod() {
+	exampleStruct := &ExamplePartialInternalStruct{}
+	untouchedStruct := &ExamplePartialInternalStruct{}
+	var _ = Expect(exampleStruct).
+		ToReceive("ExampleVariadicMethod").WithArgs(1, 2, 3).WithReturns(100).
+		ToReceive("ExampleVariadicMethod").WithArgs(1).WithReturns(101).
+		ToReceive("ExampleVariadicMethod").WithAnyArgs().AndCallsOriginal().
+		AsPartial()
+	assert.Equal(s.T(), 100, exampleStruct.ExampleVariadicMethod(1, 2, 3))
+	assert.Equal(s.T(), 100, exampleStruct.ExampleVariadicMethod(1, []int{2, 3}...))
+	assert.Equal(s.T(), 101, exampleStruct.ExampleVariadicMethod(1))
+	assert.Equal(s.T(), 10, exampleStruct.ExampleVariadicMethod(1, 4, 5), "original should be called with the variadic args")
+	assert.Equal(s.T(), 10, untouchedStruct.ExampleVariadicMethod(1, 4, 5), "untouched objects should run the original")
+}
+
+func (s *testMockPartialInternals) TestPartialNilArgsAndReturns() {
+	exampleStruct := &ExamplePartialInternalStruct{}
+	buffer := new(bytes.Buffer)
+	var _ = Expect(exampleStruct).
+		ToReceive("ExampleNillableMethod").WithArgs(nil).WithReturns(nil, nil).
+		ToReceive("ExampleNillableMethod").WithArgs(buffer).WithReturns(exampleStruct, io.EOF).
+		AsPartial()
+	ret, err := exampleStruct.ExampleNillableMethod(nil)
+	assert.Nil(s.T(), ret)
+	assert.NoError(s.T(), err)
+	ret, err = exampleStruct.ExampleNillableMethod(buffer)
+	AssertSame(s.T(), exampleStruct, ret)
+	assert.Equal(s.T(), io.EOF, err)
+}
@@ -0,0 +1,236 @@
+package monkeymock
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Spy records every call made onto the given object (which must be a pointer to a struct),
+// without declaring any expectations up front. Each call passes straight through to the
+// original method implementation (as limited when running concurrently, see AsPartial);
+// afterwards, use AssertReceived (or AssertNotReceived) to verify the calls that were made:
+//
+//	repo := monkeymock.Spy(&Repository{}).(*Repository)
+//	service.SaveAll(repo)
+//	monkeymock.AssertReceived(t, repo, "Save").WithArgs("alice").Once()
+//
+// Expectations declared via Expect for the same object still take precedence; the Spy only
+// records the calls that no declared expectation accepts. Only methods with pointer receivers
+// are spied upon (AssertReceived panics for the others). The Spy belongs to the default Controller, and is dropped along with the
+// rest of its Mocks (see AssertExpectations and ClearExpectations).
+func Spy(refObject interface{}) interface{} {
+	return gDefaultController.Spy(refObject)
+}
+
+// Spy records every call made onto the given object, for the lifetime of this Controller.
+// See the package level Spy.
+func (c *Controller) Spy(refObject interface{}) interface{} {
+	validateIsSpyableObjectRef(refObject)
+	mock := new(mockStruct)
+	mock.mockedObjectRef = refObject
+	mock.controller = c
+	mock.isSpy = true
+	for _, methodName := range spyableMethodNames(refObject) {
+		mock.ToReceive(methodName).WithAnyArgs().AndCallsOriginal()
+	}
+	c.appendToMockList(mock)
+	return mock.AsPartial()
+}
+
+// will panic if not a suitable object for a Spy
+func validateIsSpyableObjectRef(refObject interface{}) {
+	objectType := reflect.TypeOf(refObject)
+	if objectType == nil || objectType.Kind() != reflect.Ptr || objectType.Elem().Kind() != reflect.Struct {
+		panicUnspyableType(getHumanTypeName(refObject))
+	}
+}
+
+// returns the names of every exported method declared with a pointer receiver on the given object
+// - value receiver methods are left alone, as their intercepts cannot tell one object from another
+func spyableMethodNames(refObject interface{}) []string {
+	objectPtrType := reflect.TypeOf(refObject)
+	var retVal []string
+	for i := 0; i < objectPtrType.NumMethod(); i++ {
+		methodName := objectPtrType.Method(i).Name
+		if _, found := objectPtrType.Elem().MethodByName(methodName); !found {
+			retVal = append(retVal, methodName)
+		}
+	}
+	return retVal
+}
+
+///////////////////////////////////////////////////////////////////////////////
+///////////////////////////////////////////////////////////////////////////////
+
+// ReceivedAssertion verifies the calls received by an object, after the fact.
+// Each step narrows down or counts the calls of the previous step, and reports its own failure;
+// once a step has failed, the remaining steps are skipped. See AssertReceived.
+type ReceivedAssertion struct {
+	t          TestingT
+	probe      *mockMethodStruct // stands in for the method, to type check and match WithArgs
+	calls      []CallRecord      // the calls still under consideration
+	argsFilter string            // description of the WithArgs filter applied, if any
+	failed     bool
+}
+
+// AssertReceived verifies that the named method of the given object has been called at least once,
+// returning a ReceivedAssertion to narrow the verification down further:
+//
+//	monkeymock.AssertReceived(t, repo, "Save").WithArgs("alice", monkeymock.Any()).Times(2)
+//
+// Every call made onto the object through a Spy, or through any Mock targeting it, is considered.
+// Panics if calls to the method are not recorded at all (ie, value receiver methods of a Spy).
+func AssertReceived(t TestingT, refObject interface{}, methodName string) *ReceivedAssertion {
+	t.Helper()
+	a := newReceivedAssertion(t, refObject, methodName)
+	if len(a.calls) == 0 {
+		a.fail("at least once", 0)
+	}
+	return a
+}
+
+// AssertNotReceived verifies that the named method of the given object has never been called.
+// See AssertReceived.
+func AssertNotReceived(t TestingT, refObject interface{}, methodName string) bool {
+	t.Helper()
+	a := newReceivedAssertion(t, refObject, methodName)
+	if len(a.calls) > 0 {
+		a.fail("never", len(a.calls))
+	}
+	return !a.failed
+}
+
+func newReceivedAssertion(t TestingT, refObject interface{}, methodName string) *ReceivedAssertion {
+	var mocks []*mockStruct
+	for _, v := range allMocks() {
+		if mock := v.(*mockStruct); areSameObject(mock.mockedObjectRef, refObject) {
+			mocks = append(mocks, mock)
+		}
+	}
+	if len(mocks) == 0 {
+		panicObjectNotSpied(getHumanTypeName(refObject), methodName)
+	}
+	recorded := false
+	for _, mock := range mocks {
+		recorded = recorded || len(mock.mockMethodsNamed(methodName)) > 0
+	}
+	if !recorded {
+		panicMethodNotRecorded(getHumanTypeName(refObject), methodName) // or it would never appear to be called
+	}
+	a := &ReceivedAssertion{t: t}
+	a.probe = &mockMethodStruct{parentMockStruct: mocks[0], methodName: methodName}
+	for _, mock := range mocks {
+		a.calls = append(a.calls, mock.Calls(methodName)...)
+	}
+	sort.Slice(a.calls, func(i, j int) bool { return a.calls[i].Sequence < a.calls[j].Sequence })
+	return a
+}
+
+// WithArgs narrows the verification down to the calls whose args match the given values (or matchers),
+// and verifies that there is at least one. Args are given the same way as for Mock.WithArgs; any Captor
+// among them captures the args of every matching call, in call order.
+func (a *ReceivedAssertion) WithArgs(args ...interface{}) *ReceivedAssertion {
+	a.t.Helper()
+	if a.failed {
+		return a
+	}
+	a.probe.expectedArgsValues = a.probe.expectedArgsFor(args)
+	var matching []CallRecord
+	for _, call := range a.calls {
+		if a.probe.matchesArgs(call.Args) {
+			matching = append(matching, call)
+		}
+	}
+	a.argsFilter = stringifyMethodArgs(a.probe)
+	if len(matching) == 0 {
+		a.fail("at least once", 0) // listing every call received, to show how the args differ
+		return a
+	}
+	for _, call := range matching {
+		a.probe.captureArgs(call.Args)
+	}
+	a.calls = matching
+	return a
+}
+
+// Once verifies exactly one call. See Times.
+func (a *ReceivedAssertion) Once() *ReceivedAssertion {
+	a.t.Helper()
+	return a.Times(1)
+}
+
+// Twice verifies exactly two calls. See Times.
+func (a *ReceivedAssertion) Twice() *ReceivedAssertion {
+	a.t.Helper()
+	return a.Times(2)
+}
+
+// Times verifies exactly count calls (of those matching WithArgs, if given beforehand).
+func (a *ReceivedAssertion) Times(count int) *ReceivedAssertion {
+	a.t.Helper()
+	if count < 0 {
+		panicInvalidCallCount("Times()", count)
+	}
+	return a.assertCount(exactCalls(count))
+}
+
+// AtLeast verifies count or more calls. See Times.
+func (a *ReceivedAssertion) AtLeast(count int) *ReceivedAssertion {
+	a.t.Helper()
+	if count < 0 {
+		panicInvalidCallCount("AtLeast()", count)
+	}
+	return a.assertCount(atLeastCalls(count))
+}
+
+// AtMost verifies zero through count calls. See Times.
+func (a *ReceivedAssertion) AtMost(count int) *ReceivedAssertion {
+	a.t.Helper()
+	if count < 0 {
+		panicInvalidCallCount("AtMost()", count)
+	}
+	return a.assertCount(atMostCalls(count))
+}
+
+// Passed returns true if every step of the verification so far has succeeded.
+func (a *ReceivedAssertion) Passed() bool {
+	return !a.failed
+}
+
+func (a *ReceivedAssertion) assertCount(expected callCardinality) *ReceivedAssertion {
+	a.t.Helper()
+	if a.failed || expected.allows(len(a.calls)) {
+		return a
+	}
+	a.fail(expected.String(), len(a.calls))
+	return a
+}
+
+// reports the failed step and skips the remaining ones
+func (a *ReceivedAssertion) fail(expected string, actualCalls int) {
+	a.t.Helper()
+	a.failed = true
+	withargs := a.argsFilter
+	if withargs == "" {
+		withargs = "(any args)"
+	}
+	calls := ""
+	for i, v := range a.calls {
+		calls += fmt.Sprintf("          %d: args: %s\n", i+1, stringifyValuesList(v.Args))
+	}
+	if calls == "" {
+		calls = "          (no calls)\n"
+	}
+	actual := "never"
+	if actualCalls > 0 {
+		actual = timesInWords(actualCalls)
+	}
+	tFailReceived(a.t, fmt.Sprintf("Method not received as expected: \n"+
+		"method  : %s\n"+
+		"          withargs   : %s\n"+
+		"expected: %s\n"+
+		"actual  : %s\n"+
+		"calls   : \n"+
+		"%s", stringifyMethodName(a.probe), withargs, expected, actual, calls))
+}
@@ -0,0 +1,152 @@
+package monkeymock
+
+import (
+	"testing"
+
+	. "github.com/eshork/monkeymock/testsupports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestMockSpyInternals(t *testing.T) {
+	suite.Run(t, new(testMockSpyInternals))
+}
+
+type testMockSpyInternals struct {
+	suite.Suite
+}
+
+func (s *testMockSpyInternals) AfterTest(_, _ string) {
+	ClearExpectations(s.T())
+}
+
+func (s *testMockSpyInternals) TestSpyPassesThroughAndRecords() {
+	exampleStruct := Spy(&ExamplePartialInternalStruct{}).(*ExamplePartialInternalStruct)
+	untouchedStruct := &ExamplePartialInternalStruct{}
+	assert.Equal(s.T(), 7, exampleStruct.ExamplePublicMethod("junk", 7))
+	assert.Equal(s.T(), 8, exampleStruct.ExamplePublicMethod("junk", 8))
+	assert.Equal(s.T(), 6, exampleStruct.ExampleVariadicMethod(1, 2, 3))
+	assert.Equal(s.T(), 9, untouchedStruct.ExamplePublicMethod("junk", 9))
+
+	fakeT := &FakeT{}
+	assert.True(s.T(), AssertReceived(fakeT, exampleStruct, "ExamplePublicMethod").Twice().Passed())
+	assert.True(s.T(), AssertReceived(fakeT, exampleStruct, "ExamplePublicMethod").WithArgs("junk", 8).Once().Passed())
+	assert.True(s.T(), AssertReceived(fakeT, exampleStruct, "ExamplePublicMethod").WithArgs(Any(), Any()).AtLeast(2).Passed())
+	assert.True(s.T(), AssertReceived(fakeT, exampleStruct, "ExampleVariadicMethod").WithArgs(1, 2, 3).Once().Passed())
+	assert.True(s.T(), AssertNotReceived(fakeT, exampleStruct, "ExamplePublicMethod3"))
+	assert.False(s.T(), fakeT.Failed())
+
+	AssertExpectations(fakeT)
+	assert.False(s.T(), fakeT.Failed(), "a Spy has nothing to verify")
+}
+
+func (s *testMockSpyInternals) TestSpyPassesThroughNestedCalls() {
+	exampleStruct := Spy(&ExamplePartialInternalStruct{}).(*ExamplePartialInternalStruct)
+	assert.Equal(s.T(), 8, exampleStruct.ExampleNestedMethod(7), "the original should reach the nested original")
+	assert.Equal(s.T(), 9, exampleStruct.ExampleNestedMethod(8))
+
+	fakeT := &FakeT{}
+	assert.True(s.T(), AssertReceived(fakeT, exampleStruct, "ExampleNestedMethod").Twice().Passed())
+	assert.True(s.T(), AssertReceived(fakeT, exampleStruct, "ExamplePublicMethod").WithArgs("nested", 8).Once().Passed(),
+		"calls made by the original should be recorded too")
+	assert.False(s.T(), fakeT.Failed(), "%v", fakeT.Errors)
+
+	// concurrent calls may reach the original while another goroutine runs it (see AsPartial),
+	// so only the values passed through are checked here
+	withTimeout(s.T(), func() {
+		runConcurrently(func(g int, c int) {
+			assert.Equal(s.T(), c+1, exampleStruct.ExampleNestedMethod(c))
+		})
+	})
+}
+
+func (s *testMockSpyInternals) TestAssertReceivedFailures() {
+	exampleStruct := Spy(&ExamplePartialInternalStruct{}).(*ExamplePartialInternalStruct)
+	exampleStruct.ExamplePublicMethod("junk", 7)
+
+	fakeT := &FakeT{}
+	assert.False(s.T(), AssertReceived(fakeT, exampleStruct, "ExamplePublicMethod3").Once().Passed())
+	require.Len(s.T(), fakeT.Errors, 1, "later steps should be skipped after a failure")
+	assert.Contains(s.T(), fakeT.Errors[0], "ExamplePublicMethod3")
+	assert.Contains(s.T(), fakeT.Errors[0], "expected: at least once")
+
+	fakeT = &FakeT{}
+	assert.False(s.T(), AssertReceived(fakeT, exampleStruct, "ExamplePublicMethod").WithArgs("junk", 8).Twice().Passed())
+	require.Len(s.T(), fakeT.Errors, 1)
+	assert.Contains(s.T(), fakeT.Errors[0], `"junk" <string>, 8 <int>`)
+	assert.Contains(s.T(), fakeT.Errors[0], `1: args: "junk" <string>, 7 <int>`, "every call received should be listed")
+
+	fakeT = &FakeT{}
+	assert.False(s.T(), AssertReceived(fakeT, exampleStruct, "ExamplePublicMethod").Twice().Passed())
+	require.Len(s.T(), fakeT.Errors, 1)
+	assert.Contains(s.T(), fakeT.Errors[0], "expected: exactly twice")
+	assert.Contains(s.T(), fakeT.Errors[0], "actual  : once")
+
+	fakeT = &FakeT{}
+	assert.False(s.T(), AssertNotReceived(fakeT, exampleStruct, "ExamplePublicMethod"))
+	assert.True(s.T(), fakeT.Failed())
+
+	assert.Panics(s.T(), func() {
+		AssertReceived(fakeT, exampleStruct, "ExamplePublicMethod").WithArgs("junk")
+	}, "WithArgs should be type checked")
+	assert.Panics(s.T(), func() {
+		AssertReceived(fakeT, &ExamplePartialInternalStruct{}, "ExamplePublicMethod")
+	}, "objects that are not spied upon cannot be verified")
+	assert.Panics(s.T(), func() {
+		AssertNotReceived(fakeT, exampleStruct, "ExamplePublicMethod2")
+	}, "value receiver methods are not recorded, so cannot be verified")
+	assert.Panics(s.T(), func() {
+		AssertReceived(fakeT, exampleStruct, "NoSuchMethod")
+	})
+}
+
+func (s *testMockSpyInternals) TestAssertReceivedCaptures() {
+	exampleStruct := Spy(&ExamplePartialInternalStruct{}).(*ExamplePartialInternalStruct)
+	exampleStruct.ExamplePublicMethod("first", 7)
+	exampleStruct.ExamplePublicMethod("second", 8)
+	exampleStruct.ExamplePublicMethod("third", 7)
+
+	label := NewCaptor()
+	fakeT := &FakeT{}
+	assert.True(s.T(), AssertReceived(fakeT, exampleStruct, "ExamplePublicMethod").WithArgs(label, 7).Twice().Passed())
+	assert.Equal(s.T(), []string{"first", "third"}, CapturedValues[string](label), "only the matching calls are captured")
+
+	unmatched := NewCaptor()
+	assert.False(s.T(), AssertReceived(fakeT, exampleStruct, "ExamplePublicMethod").WithArgs(unmatched, 9).Passed())
+	assert.Empty(s.T(), unmatched.Values())
+}
+
+func (s *testMockSpyInternals) TestSpyRequiresStructPointer() {
+	assert.Panics(s.T(), func() { Spy(ExamplePartialInternalStruct{}) })
+	assert.Panics(s.T(), func() { Spy(7) })
+	assert.Panics(s.T(), func() { Spy(nil) })
+}
+
+func (s *testMockSpyInternals) TestDeclaredExpectationsTakePrecedence() {
+	exampleStruct := &ExamplePartialInternalStruct{}
+	Spy(exampleStruct)
+	var _ = Expect(exampleStruct).ToReceive("ExamplePublicMethod").WithArgs("junk", 7).Once().WithReturns(100).AsPartial()
+	assert.Equal(s.T(), 100, exampleStruct.ExamplePublicMethod("junk", 7))
+	assert.Equal(s.T(), 8, exampleStruct.ExamplePublicMethod("junk", 8), "unexpected args should pass through to the original")
+
+	fakeT := &FakeT{}
+	assert.True(s.T(), AssertReceived(fakeT, exampleStruct, "ExamplePublicMethod").Twice().Passed())
+	AssertExpectations(fakeT)
+	assert.False(s.T(), fakeT.Failed(), "the call passed through the Spy is not unexpected")
+}
+
+func (s *testMockSpyInternals) TestSpyInterceptsReleasedWithController() {
+	exampleStruct := &ExamplePartialInternalStruct{}
+	fakeT := &FakeT{}
+	ctrl := NewController(fakeT)
+	ctrl.Spy(exampleStruct)
+	exampleStruct.ExamplePublicMethod("junk", 7)
+	clearUnusedPartialObjectMethodIntercepts()
+	require.NotZero(s.T(), len(interceptRecords), "Spy intercepts should remain in use")
+	assert.True(s.T(), AssertReceived(fakeT, exampleStruct, "ExamplePublicMethod").Once().Passed())
+
+	fakeT.RunCleanups()
+	assert.False(s.T(), fakeT.Failed())
+	assert.Zero(s.T(), len(interceptRecords), "Spy intercepts should be released with the Controller")
+}
@@ -0,0 +1,56 @@
+package monkeymock
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+// reentrantMutex is a mutual exclusion lock that may be locked again by the goroutine
+// already holding it; it is released once Unlock has been called as many times as Lock.
+type reentrantMutex struct {
+	lock  sync.Mutex
+	cond  *sync.Cond
+	owner uint64 // goroutine ID of the holder
+	depth int    // times the holder has locked it
+}
+
+func newReentrantMutex() *reentrantMutex {
+	r := new(reentrantMutex)
+	r.cond = sync.NewCond(&r.lock)
+	return r
+}
+
+func (r *reentrantMutex) Lock() {
+	id := currentGoroutineID()
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for r.depth > 0 && r.owner != id {
+		r.cond.Wait()
+	}
+	r.owner = id
+	r.depth++
+}
+
+func (r *reentrantMutex) Unlock() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.depth--
+	if r.depth == 0 {
+		r.owner = 0
+		r.cond.Broadcast()
+	}
+}
+
+// returns the ID of the calling goroutine, as reported in its stack trace header ("goroutine 7 [running]:")
+func currentGoroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+	if i := bytes.IndexByte(buf, ' '); i >= 0 {
+		buf = buf[:i]
+	}
+	id, _ := strconv.ParseUint(string(buf), 10, 64)
+	return id
+}
@@ -75,3 +75,47 @@ func AssertSame(t assert.TestingT, expected, actual interface{}, msgAndArgs ...i
 type tHelper interface {
 	Helper()
 }
+
+// FakeT records failures and cleanup functions, standing in for *testing.T
+type FakeT struct {
+	Errors   []string
+	Fatals   []string
+	Logs     []string
+	cleanups []func()
+}
+
+// Helper ...
+func (t *FakeT) Helper() {}
+
+// Errorf ...
+func (t *FakeT) Errorf(format string, args ...interface{}) {
+	t.Errors = append(t.Errors, fmt.Sprintf(format, args...))
+}
+
+// Fatalf ...
+func (t *FakeT) Fatalf(format string, args ...interface{}) {
+	t.Fatals = append(t.Fatals, fmt.Sprintf(format, args...))
+}
+
+// Logf ...
+func (t *FakeT) Logf(format string, args ...interface{}) {
+	t.Logs = append(t.Logs, fmt.Sprintf(format, args...))
+}
+
+// Cleanup ...
+func (t *FakeT) Cleanup(fn func()) {
+	t.cleanups = append(t.cleanups, fn)
+}
+
+// Failed ...
+func (t *FakeT) Failed() bool {
+	return len(t.Errors) > 0 || len(t.Fatals) > 0
+}
+
+// RunCleanups calls the registered cleanup functions, last registered first (like *testing.T)
+func (t *FakeT) RunCleanups() {
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.cleanups[i]()
+	}
+	t.cleanups = nil
+}